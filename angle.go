@@ -0,0 +1,168 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"fmt"
+	"math"
+)
+
+// A DualAngle represents the dual angle θ + dε between two spatial lines, with
+// θ the angle between their directions and d the distance between them along
+// their common normal.
+type DualAngle [2]float64
+
+// Angle returns the angle θ of z, a float64 value.
+func (z *DualAngle) Angle() float64 {
+	return z[0]
+}
+
+// Distance returns the distance d of z, a float64 value.
+func (z *DualAngle) Distance() float64 {
+	return z[1]
+}
+
+// String returns the string version of a DualAngle value.
+//
+// If z = θ + dε, then the string is "(θ+dε)", similar to Real values.
+func (z *DualAngle) String() string {
+	return (*Real)(z).String()
+}
+
+// Format implements the fmt.Formatter interface for DualAngle values, in the
+// same way as for Real values.
+func (z *DualAngle) Format(s fmt.State, verb rune) {
+	symb := symbols(symbReal[:], symbRealASCII[:])
+	formatComponents(s, verb, z[:], symb, z)
+}
+
+// GoString returns the Go syntax of a DualAngle value, as a call to
+// NewDualAngle. It is used by the "%#v" verb.
+func (z *DualAngle) GoString() string {
+	return goCall("dual.NewDualAngle", z[0], z[1])
+}
+
+// Equals returns true if z and y are equal.
+func (z *DualAngle) Equals(y *DualAngle) bool {
+	return (*Real)(z).Equals((*Real)(y))
+}
+
+// Copy copies y onto z, and returns z.
+func (z *DualAngle) Copy(y *DualAngle) *DualAngle {
+	z[0] = y[0]
+	z[1] = y[1]
+	return z
+}
+
+// NewDualAngle returns a pointer to a DualAngle value made from an angle θ and
+// a distance d.
+func NewDualAngle(θ, d float64) *DualAngle {
+	return &DualAngle{θ, d}
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *DualAngle) Neg(y *DualAngle) *DualAngle {
+	z[0] = -y[0]
+	z[1] = -y[1]
+	return z
+}
+
+// Add sets z equal to the sum of x and y, and returns z.
+//
+// Dual angles about a common normal add like ordinary angles: the angles add,
+// and the distances add.
+func (z *DualAngle) Add(x, y *DualAngle) *DualAngle {
+	z[0] = x[0] + y[0]
+	z[1] = x[1] + y[1]
+	return z
+}
+
+// Sub sets z equal to the difference of x and y, and returns z.
+func (z *DualAngle) Sub(x, y *DualAngle) *DualAngle {
+	z[0] = x[0] - y[0]
+	z[1] = x[1] - y[1]
+	return z
+}
+
+// Sin returns the dual sine of z, a pointer to a Real value:
+// 		sin(θ + dε) = sin(θ) + d cos(θ)ε
+func (z *DualAngle) Sin() *Real {
+	return new(Real).Sin((*Real)(z))
+}
+
+// Cos returns the dual cosine of z, a pointer to a Real value:
+// 		cos(θ + dε) = cos(θ) - d sin(θ)ε
+func (z *DualAngle) Cos() *Real {
+	return new(Real).Cos((*Real)(z))
+}
+
+// Tan returns the dual tangent of z, a pointer to a Real value:
+// 		tan(θ + dε) = tan(θ) + d sec²(θ)ε
+func (z *DualAngle) Tan() *Real {
+	c := math.Cos(z[0])
+	return NewReal(math.Tan(z[0]), z[1]/(c*c))
+}
+
+// DualAngleAtan2 returns a pointer to the DualAngle value whose dual sine and
+// dual cosine are proportional to s and c. This is the dual analog of
+// math.Atan2.
+func DualAngleAtan2(s, c *Real) *DualAngle {
+	a, b := s.Cartesian()
+	p, q := c.Cartesian()
+	return &DualAngle{
+		math.Atan2(a, p),
+		((p * b) - (a * q)) / ((a * a) + (p * p)),
+	}
+}
+
+// DualAngleBetween returns a pointer to the DualAngle value between two lines.
+// The first line passes through the point p with direction u, and the second
+// line passes through the point q with direction v. The directions need not
+// be unit vectors.
+//
+// The angle is measured from u to v about the common normal u × v, and the
+// distance is the signed distance from the first line to the second one along
+// that normal. For parallel lines, the distance is non-negative.
+func DualAngleBetween(p, u, q, v [3]float64) *DualAngle {
+	u = unit3(u)
+	v = unit3(v)
+	w := sub3(q, p)
+	n := cross3(u, v)
+	s := norm3(n)
+	θ := math.Atan2(s, dot3(u, v))
+	if s <= delta {
+		return &DualAngle{θ, norm3(cross3(u, w))}
+	}
+	return &DualAngle{θ, dot3(w, n) / s}
+}
+
+// dot3 returns the dot product of two 3-vectors.
+func dot3(u, v [3]float64) float64 {
+	return (u[0] * v[0]) + (u[1] * v[1]) + (u[2] * v[2])
+}
+
+// cross3 returns the cross product of two 3-vectors.
+func cross3(u, v [3]float64) [3]float64 {
+	return [3]float64{
+		(u[1] * v[2]) - (u[2] * v[1]),
+		(u[2] * v[0]) - (u[0] * v[2]),
+		(u[0] * v[1]) - (u[1] * v[0]),
+	}
+}
+
+// sub3 returns the difference of two 3-vectors.
+func sub3(u, v [3]float64) [3]float64 {
+	return [3]float64{u[0] - v[0], u[1] - v[1], u[2] - v[2]}
+}
+
+// norm3 returns the Euclidean length of a 3-vector.
+func norm3(u [3]float64) float64 {
+	return math.Sqrt(dot3(u, u))
+}
+
+// unit3 returns a 3-vector scaled to unit length.
+func unit3(u [3]float64) [3]float64 {
+	n := norm3(u)
+	return [3]float64{u[0] / n, u[1] / n, u[2] / n}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDualAngleFunctions(t *testing.T) {
+	for _, θ := range []*DualAngle{
+		NewDualAngle(0.5, 0.3),
+		NewDualAngle(-2, 1.5),
+		NewDualAngle(0, 2), // a pure dual angle
+	} {
+		x := NewReal(θ.Angle(), θ.Distance())
+		if got, want := θ.Sin(), new(Real).Sin(x); !got.Equals(want) {
+			t.Errorf("%v.Sin() = %v, want %v", θ, got, want)
+		}
+		if got, want := θ.Cos(), new(Real).Cos(x); !got.Equals(want) {
+			t.Errorf("%v.Cos() = %v, want %v", θ, got, want)
+		}
+		if got, want := θ.Tan(), new(Real).Quo(θ.Sin(), θ.Cos()); !got.Equals(want) {
+			t.Errorf("%v.Tan() = %v, want Sin/Cos = %v", θ, got, want)
+		}
+	}
+	// A pure dual angle dε has sine and tangent dε and cosine 1.
+	θ := NewDualAngle(0, 2)
+	if got := θ.Sin(); !got.Equals(NewReal(0, 2)) {
+		t.Errorf("%v.Sin() = %v, want (0+2ε)", θ, got)
+	}
+	if got := θ.Cos(); !got.Equals(NewReal(1, 0)) {
+		t.Errorf("%v.Cos() = %v, want (1+0ε)", θ, got)
+	}
+	if got := θ.Tan(); !got.Equals(NewReal(0, 2)) {
+		t.Errorf("%v.Tan() = %v, want (0+2ε)", θ, got)
+	}
+}
+
+func TestDualAngleAtan2(t *testing.T) {
+	// The angle is recovered in every quadrant and on the axes, from a dual
+	// sine and cosine scaled by the same factor with a positive real part.
+	k := NewReal(2, -0.5)
+	for _, a := range []float64{0, 0.5, math.Pi / 2, 2, math.Pi, -2.5, -math.Pi / 2, -0.7} {
+		θ := NewDualAngle(a, 0.3)
+		s := new(Real).Mul(k, θ.Sin())
+		c := new(Real).Mul(k, θ.Cos())
+		if got := DualAngleAtan2(s, c); !got.Equals(θ) {
+			t.Errorf("DualAngleAtan2(%v, %v) = %v, want %v", s, c, got, θ)
+		}
+	}
+	if got := DualAngleAtan2(NewReal(0, 1), NewReal(1, 0)); !got.Equals(NewDualAngle(0, 1)) {
+		t.Errorf("DualAngleAtan2((0+1ε), (1+0ε)) = %v, want (0+1ε)", got)
+	}
+}
+
+func TestDualAngleBetween(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		p, u, q, v [3]float64
+		want       *DualAngle
+	}{
+		{
+			"skew",
+			[3]float64{0, 0, 0}, [3]float64{1, 0, 0},
+			[3]float64{0, 0, 2}, [3]float64{1, 1, 0},
+			NewDualAngle(math.Pi/4, 2),
+		},
+		{
+			"parallel",
+			[3]float64{0, 0, 0}, [3]float64{1, 0, 0},
+			[3]float64{5, 2, 0}, [3]float64{3, 0, 0},
+			NewDualAngle(0, 2),
+		},
+		{
+			"antiparallel",
+			[3]float64{0, 0, 0}, [3]float64{1, 0, 0},
+			[3]float64{5, 0, -2}, [3]float64{-1, 0, 0},
+			NewDualAngle(math.Pi, 2),
+		},
+		{
+			"intersecting",
+			[3]float64{1, 1, 1}, [3]float64{0, 1, 0},
+			[3]float64{1, 1, 1}, [3]float64{0, 0, -4},
+			NewDualAngle(math.Pi/2, 0),
+		},
+	} {
+		if got := DualAngleBetween(test.p, test.u, test.q, test.v); !got.Equals(test.want) {
+			t.Errorf("DualAngleBetween (%s) = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestDualAngleArithmetic(t *testing.T) {
+	x, y := NewDualAngle(0.5, 1), NewDualAngle(-2, 0.25)
+	if got := new(DualAngle).Add(x, y); !got.Equals(NewDualAngle(-1.5, 1.25)) {
+		t.Errorf("%v + %v = %v", x, y, got)
+	}
+	if got := new(DualAngle).Sub(x, y); !got.Equals(NewDualAngle(2.5, 0.75)) {
+		t.Errorf("%v - %v = %v", x, y, got)
+	}
+	if got := new(DualAngle).Neg(x); !got.Equals(NewDualAngle(-0.5, -1)) {
+		t.Errorf("-%v = %v", x, got)
+	}
+	if got := x.String(); got != "(0.5+1ε)" {
+		t.Errorf("String() = %q, want %q", got, "(0.5+1ε)")
+	}
+}
@@ -0,0 +1,14 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+// HamiltonBatchMul sets dst[i] equal to the product of a[i] and b[i] for each
+// index i. The slices may alias one another element by element, as with Mul.
+// If the slices do not have the same length, then HamiltonBatchMul panics. On
+// CPUs with AVX2, four products are computed at a time, with the same results
+// as Mul bit for bit.
+func HamiltonBatchMul(dst, a, b []Hamilton) {
+	checkLen(len(dst), len(a), len(b))
+	mulHamiltons(dst, a, b)
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+//go:build !purego && !amd64.v3
+
+package dual
+
+// The AVX2 kernel of HamiltonBatchMul leaves out FMA to match the complex128
+// arithmetic of Mul bit for bit. With GOAMD64=v3 or later, the compiler fuses
+// that arithmetic, so the kernel is left out and the Go loop is used.
+
+// mulHamiltonsAVX2 computes the products of the first len(z)/4*4 elements,
+// four at a time. Each block is turned into vectors of matching components,
+// multiplied without FMA in the order of the complex128 arithmetic of Mul, and
+// turned back. The terms that Mul leaves out for a zero dual part are masked
+// to zero in the same cases.
+//
+//go:noescape
+func mulHamiltonsAVX2(z, x, y []Hamilton)
+
+// mulHamiltonsAsm is mulHamiltonsGo with the bulk of the work done by
+// mulHamiltonsAVX2.
+func mulHamiltonsAsm(z, x, y []Hamilton) {
+	n := len(z) &^ 3
+	mulHamiltonsAVX2(z[:n], x[:n], y[:n])
+	mulHamiltonsGo(z[n:], x[n:], y[n:])
+}
+
+func init() {
+	if hasAVX2() {
+		mulHamiltons = mulHamiltonsAsm
+	}
+}
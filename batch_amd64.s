@@ -0,0 +1,182 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+//go:build !purego && !amd64.v3
+
+#include "textflag.h"
+
+// TRANSPOSE turns the rows r0, r1, r2, and r3 of a 4×4 block of float64 values
+// into its columns, in place, with t0 through t3 as scratch.
+#define TRANSPOSE(r0, r1, r2, r3, t0, t1, t2, t3) \
+	VUNPCKLPD  r1, r0, t0       \
+	VUNPCKHPD  r1, r0, t1       \
+	VUNPCKLPD  r3, r2, t2       \
+	VUNPCKHPD  r3, r2, t3       \
+	VPERM2F128 $0x20, t2, t0, r0 \
+	VPERM2F128 $0x20, t3, t1, r1 \
+	VPERM2F128 $0x31, t2, t0, r2 \
+	VPERM2F128 $0x31, t3, t1, r3
+
+// MULQUAT sets r0 through r3 to the components of the quaternion product of
+// a0 through a3 and c0 through c3, with t0 and t1 as scratch. With a = a0 + a1i
+// and b = a2 + a3i, and c = c0 + c1i and d = c2 + c3i, the product is
+// (ac - bd*, ad + bc*), and each component is computed with the operations of
+// the complex128 arithmetic of mulQuat, in the same order.
+#define MULQUAT(a0, a1, a2, a3, c0, c1, c2, c3, r0, r1, r2, r3, t0, t1) \
+	VMULPD c0, a0, r0 \
+	VMULPD c1, a1, t0 \
+	VSUBPD t0, r0, r0 \
+	VMULPD c2, a2, t0 \
+	VMULPD c3, a3, t1 \
+	VADDPD t1, t0, t0 \
+	VSUBPD t0, r0, r0 \
+	VMULPD c1, a0, r1 \
+	VMULPD c0, a1, t0 \
+	VADDPD t0, r1, r1 \
+	VMULPD c2, a3, t0 \
+	VMULPD c3, a2, t1 \
+	VSUBPD t1, t0, t0 \
+	VSUBPD t0, r1, r1 \
+	VMULPD c2, a0, r2 \
+	VMULPD c3, a1, t0 \
+	VSUBPD t0, r2, r2 \
+	VMULPD c0, a2, t0 \
+	VMULPD c1, a3, t1 \
+	VADDPD t1, t0, t0 \
+	VADDPD t0, r2, r2 \
+	VMULPD c3, a0, r3 \
+	VMULPD c2, a1, t0 \
+	VADDPD t0, r3, r3 \
+	VMULPD c0, a3, t0 \
+	VMULPD c1, a2, t1 \
+	VSUBPD t1, t0, t0 \
+	VADDPD t0, r3, r3
+
+// KEEP sets each lane of m to all ones if any of d0 through d3 is nonzero or
+// NaN, or any of q0 through q3 is NaN, and to zero otherwise, with zero a
+// register of zeros and t as scratch. These are the lanes where Mul keeps the
+// term of the dual part d multiplied by the quaternion q.
+#define KEEP(d0, d1, d2, d3, q0, q1, q2, q3, zero, m, t) \
+	VCMPPD $4, zero, d0, m \
+	VCMPPD $4, zero, d1, t \
+	VORPD  t, m, m         \
+	VCMPPD $4, zero, d2, t \
+	VORPD  t, m, m         \
+	VCMPPD $4, zero, d3, t \
+	VORPD  t, m, m         \
+	VCMPPD $3, q0, q0, t   \
+	VORPD  t, m, m         \
+	VCMPPD $3, q1, q1, t   \
+	VORPD  t, m, m         \
+	VCMPPD $3, q2, q2, t   \
+	VORPD  t, m, m         \
+	VCMPPD $3, q3, q3, t   \
+	VORPD  t, m, m
+
+// func mulHamiltonsAVX2(z, x, y []Hamilton)
+//
+// Each Hamilton is eight float64 values, the quaternions x[0] and x[1] of four
+// components each. For a block of four elements, the quaternions are loaded as
+// the rows of a 4×4 block and transposed, so that each register holds one
+// component of four quaternions. With x = x₀ + x₁ε and y = y₀ + y₁ε, Mul gives
+// z₀ = x₀y₀ and z₁ = y₁x₀ + x₁y₀*, where the first term is kept as in KEEP(y₁,
+// x₀) and the second as in KEEP(x₁, y₀). The frame holds z₀ at 0(SP) and the
+// first term of z₁ at 128(SP), so that nothing is stored before every input
+// of the block is loaded, and the outputs may alias the inputs.
+TEXT ·mulHamiltonsAVX2(SB), NOSPLIT, $256-72
+	MOVQ     z_base+0(FP), DI
+	MOVQ     z_len+8(FP), CX
+	MOVQ     x_base+24(FP), SI
+	MOVQ     y_base+48(FP), DX
+	SHRQ     $2, CX
+	VXORPD   Y14, Y14, Y14
+	VPCMPEQQ Y15, Y15, Y15
+	VPSLLQ   $63, Y15, Y15
+
+hamilton4:
+	TESTQ CX, CX
+	JZ    hamiltonDone
+
+	// z₀ = x₀y₀
+	VMOVUPD (SI), Y0
+	VMOVUPD 64(SI), Y1
+	VMOVUPD 128(SI), Y2
+	VMOVUPD 192(SI), Y3
+	TRANSPOSE(Y0, Y1, Y2, Y3, Y8, Y9, Y10, Y11)
+	VMOVUPD (DX), Y4
+	VMOVUPD 64(DX), Y5
+	VMOVUPD 128(DX), Y6
+	VMOVUPD 192(DX), Y7
+	TRANSPOSE(Y4, Y5, Y6, Y7, Y8, Y9, Y10, Y11)
+	MULQUAT(Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y10, Y11, Y12, Y13)
+	VMOVUPD Y8, (SP)
+	VMOVUPD Y9, 32(SP)
+	VMOVUPD Y10, 64(SP)
+	VMOVUPD Y11, 96(SP)
+
+	// y₁x₀
+	VMOVUPD 32(DX), Y4
+	VMOVUPD 96(DX), Y5
+	VMOVUPD 160(DX), Y6
+	VMOVUPD 224(DX), Y7
+	TRANSPOSE(Y4, Y5, Y6, Y7, Y8, Y9, Y10, Y11)
+	MULQUAT(Y4, Y5, Y6, Y7, Y0, Y1, Y2, Y3, Y8, Y9, Y10, Y11, Y12, Y13)
+	KEEP(Y4, Y5, Y6, Y7, Y0, Y1, Y2, Y3, Y14, Y12, Y13)
+	VANDPD  Y12, Y8, Y8
+	VANDPD  Y12, Y9, Y9
+	VANDPD  Y12, Y10, Y10
+	VANDPD  Y12, Y11, Y11
+	VMOVUPD Y8, 128(SP)
+	VMOVUPD Y9, 160(SP)
+	VMOVUPD Y10, 192(SP)
+	VMOVUPD Y11, 224(SP)
+
+	// x₁y₀*
+	VMOVUPD 32(SI), Y0
+	VMOVUPD 96(SI), Y1
+	VMOVUPD 160(SI), Y2
+	VMOVUPD 224(SI), Y3
+	TRANSPOSE(Y0, Y1, Y2, Y3, Y8, Y9, Y10, Y11)
+	VMOVUPD (DX), Y4
+	VMOVUPD 64(DX), Y5
+	VMOVUPD 128(DX), Y6
+	VMOVUPD 192(DX), Y7
+	TRANSPOSE(Y4, Y5, Y6, Y7, Y8, Y9, Y10, Y11)
+	VXORPD  Y15, Y5, Y5
+	VXORPD  Y15, Y6, Y6
+	VXORPD  Y15, Y7, Y7
+	MULQUAT(Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y10, Y11, Y12, Y13)
+	KEEP(Y0, Y1, Y2, Y3, Y4, Y5, Y6, Y7, Y14, Y12, Y13)
+	VANDPD  Y12, Y8, Y8
+	VANDPD  Y12, Y9, Y9
+	VANDPD  Y12, Y10, Y10
+	VANDPD  Y12, Y11, Y11
+
+	// z₁ = y₁x₀ + x₁y₀*
+	VADDPD  128(SP), Y8, Y8
+	VADDPD  160(SP), Y9, Y9
+	VADDPD  192(SP), Y10, Y10
+	VADDPD  224(SP), Y11, Y11
+	TRANSPOSE(Y8, Y9, Y10, Y11, Y0, Y1, Y2, Y3)
+	VMOVUPD (SP), Y4
+	VMOVUPD 32(SP), Y5
+	VMOVUPD 64(SP), Y6
+	VMOVUPD 96(SP), Y7
+	TRANSPOSE(Y4, Y5, Y6, Y7, Y0, Y1, Y2, Y3)
+	VMOVUPD Y4, (DI)
+	VMOVUPD Y8, 32(DI)
+	VMOVUPD Y5, 64(DI)
+	VMOVUPD Y9, 96(DI)
+	VMOVUPD Y6, 128(DI)
+	VMOVUPD Y10, 160(DI)
+	VMOVUPD Y7, 192(DI)
+	VMOVUPD Y11, 224(DI)
+	ADDQ    $256, SI
+	ADDQ    $256, DX
+	ADDQ    $256, DI
+	DECQ    CX
+	JMP     hamilton4
+
+hamiltonDone:
+	VZEROUPPER
+	RET
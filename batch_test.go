@@ -0,0 +1,114 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestHamiltonBatchMul(t *testing.T) {
+	a := []Hamilton{*NewHamilton(1, 2, 3, 4, 5, 6, 7, 8), *NewHamilton(0, 1, 0, 0, 0, 0, 1, 0)}
+	b := []Hamilton{*NewHamilton(8, 7, 6, 5, 4, 3, 2, 1), *NewHamilton(0, 0, 1, 0, 1, 0, 0, 0)}
+	dst := make([]Hamilton, 2)
+	HamiltonBatchMul(dst, a, b)
+	for i := range dst {
+		if want := new(Hamilton).Mul(&a[i], &b[i]); !dst[i].Equals(want) {
+			t.Errorf("dst[%d] = %v, want %v", i, &dst[i], want)
+		}
+	}
+}
+
+// hamiltonFloats returns the components of v, eight per element.
+func hamiltonFloats(v []Hamilton) []float64 {
+	f := make([]float64, 0, 8*len(v))
+	for i := range v {
+		f = append(f, v[i].components()...)
+	}
+	return f
+}
+
+func TestHamiltonBatchMulKernel(t *testing.T) {
+	// The selected kernel must match Mul bit for bit, for every length around
+	// the block size, and when dst aliases an input.
+	rnd := rand.New(rand.NewSource(1))
+	randHamiltons := func(n int) []Hamilton {
+		v := make([]Hamilton, n)
+		for i := range v {
+			var f [8]float64
+			for j := range f {
+				f[j] = rnd.NormFloat64() * math.Exp2(float64(rnd.Intn(40)-20))
+			}
+			v[i] = *NewHamilton(f[0], f[1], f[2], f[3], f[4], f[5], f[6], f[7])
+		}
+		return v
+	}
+	for n := 0; n <= 19; n++ {
+		x, y := randHamiltons(n), randHamiltons(n)
+		got, want := make([]Hamilton, n), make([]Hamilton, n)
+		mulHamiltons(got, x, y)
+		mulHamiltonsGo(want, x, y)
+		if !floatsEqual(hamiltonFloats(got), hamiltonFloats(want)) {
+			t.Errorf("mulHamiltons(n = %d) = %v, want %v", n, got, want)
+		}
+		mulHamiltons(x, x, y)
+		if !floatsEqual(hamiltonFloats(x), hamiltonFloats(want)) {
+			t.Errorf("mulHamiltons in place (n = %d) = %v, want %v", n, x, want)
+		}
+	}
+}
+
+func TestHamiltonBatchMulNonFinite(t *testing.T) {
+	// Each quaternion part is zero, finite, or holds an infinite or NaN
+	// component, so that every case where Mul leaves out a term of the dual
+	// part is tried at each position of a block.
+	parts := [][4]float64{
+		{0, 0, 0, 0},
+		{math.Copysign(0, -1), 0, 0, math.Copysign(0, -1)},
+		{1, -2, 0.5, 3},
+		{0, math.Inf(1), 0, 0},
+		{0, 0, math.NaN(), 1},
+	}
+	var x, y []Hamilton
+	for _, p := range parts {
+		for _, q := range parts {
+			for _, r := range parts {
+				for _, s := range parts {
+					x = append(x, *NewHamilton(p[0], p[1], p[2], p[3], q[0], q[1], q[2], q[3]))
+					y = append(y, *NewHamilton(r[0], r[1], r[2], r[3], s[0], s[1], s[2], s[3]))
+				}
+			}
+		}
+	}
+	got, want := make([]Hamilton, len(x)), make([]Hamilton, len(x))
+	mulHamiltons(got, x, y)
+	mulHamiltonsGo(want, x, y)
+	g, w := hamiltonFloats(got), hamiltonFloats(want)
+	for i := range g {
+		if math.Float64bits(g[i]) != math.Float64bits(w[i]) && !(math.IsNaN(g[i]) && math.IsNaN(w[i])) {
+			t.Errorf("mulHamiltons(%v, %v) = %v, want %v", &x[i/8], &y[i/8], &got[i/8], &want[i/8])
+		}
+	}
+}
+
+func BenchmarkHamiltonBatchMul(b *testing.B) {
+	const n = 1024
+	x, y, dst := make([]Hamilton, n), make([]Hamilton, n), make([]Hamilton, n)
+	for i := range x {
+		f := float64(i)
+		x[i] = *NewHamilton(f, 1, 2, 3, 4, 5, 6, 7)
+		y[i] = *NewHamilton(7, 6, 5, 4, 3, 2, 1, f)
+	}
+	b.Run("kernel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			HamiltonBatchMul(dst, x, y)
+		}
+	})
+	b.Run("Go", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			mulHamiltonsGo(dst, x, y)
+		}
+	})
+}
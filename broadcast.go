@@ -0,0 +1,84 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+// The methods in this file combine a vector or slice with a scalar Real value,
+// which is broadcast to every element. The result has the length of z, and
+// every vector or slice operand must have that length too; otherwise the
+// methods panic, as the other methods of DualVector and DualSlice do. The
+// scalar is read before z is written, so it may alias an element of z.
+
+// Fill sets every component of z equal to a, and returns z.
+func (z DualVector) Fill(a *Real) DualVector {
+	b := *a
+	for i := range z {
+		z[i] = b
+	}
+	return z
+}
+
+// AddScalar sets z equal to y with a added to each component, and returns z.
+func (z DualVector) AddScalar(y DualVector, a *Real) DualVector {
+	checkLen(len(z), len(y))
+	b := *a
+	for i := range z {
+		z[i].Add(&y[i], &b)
+	}
+	return z
+}
+
+// AXPY sets z equal to a times x plus y, and returns z.
+func (z DualVector) AXPY(a *Real, x, y DualVector) DualVector {
+	checkLen(len(z), len(x), len(y))
+	b := *a
+	var p Real
+	for i := range z {
+		p.Mul(&b, &x[i])
+		z[i].Add(&p, &y[i])
+	}
+	return z
+}
+
+// Fill sets every element of z equal to a, and returns z.
+func (z DualSlice) Fill(a *Real) DualSlice {
+	n := z.check()
+	c, d := a.Cartesian()
+	zr, zd := z.Real[:n], z.Dual[:n]
+	for i := range zr {
+		zr[i] = c
+		zd[i] = d
+	}
+	return z
+}
+
+// AddScalar sets each element of z equal to the matching element of y plus a,
+// and returns z.
+func (z DualSlice) AddScalar(y DualSlice, a *Real) DualSlice {
+	n := z.check(y)
+	c, d := a.Cartesian()
+	zr, zd := z.Real[:n], z.Dual[:n]
+	yr, yd := y.Real[:n], y.Dual[:n]
+	for i := range zr {
+		zr[i] = yr[i] + c
+		zd[i] = yd[i] + d
+	}
+	return z
+}
+
+// AXPY sets each element of z equal to a times the matching element of x plus
+// the matching element of y, and returns z. The result matches DualVector.AXPY
+// element by element.
+func (z DualSlice) AXPY(a *Real, x, y DualSlice) DualSlice {
+	n := z.check(x, y)
+	c, d := a.Cartesian()
+	zr, zd := z.Real[:n], z.Dual[:n]
+	xr, xd := x.Real[:n], x.Dual[:n]
+	yr, yd := y.Real[:n], y.Dual[:n]
+	for i := range zr {
+		p, q := xr[i], xd[i]
+		zr[i] = (c * p) + yr[i]
+		zd[i] = mulDualPart(c, d, p, q) + yd[i]
+	}
+	return z
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "testing"
+
+func TestBroadcast(t *testing.T) {
+	x := DualVector{{3, 1}, {4, 2}, {0.5, -1}}
+	y := DualVector{{1, 0}, {2, 1}, {-2, 3}}
+	a := NewReal(2, -1)
+	if got, want := make(DualVector, 3).Fill(a), (DualVector{{2, -1}, {2, -1}, {2, -1}}); !got.Equals(want) {
+		t.Errorf("Fill = %v, want %v", got, want)
+	}
+	if got, want := make(DualVector, 3).AddScalar(x, a), (DualVector{{5, 0}, {6, 1}, {2.5, -2}}); !got.Equals(want) {
+		t.Errorf("AddScalar = %v, want %v", got, want)
+	}
+	// a·x + y, with (2 - ε)(3 + ε) = 6 - ε.
+	want := DualVector{{7, -1}, {10, 1}, {-1, 0.5}}
+	if got := make(DualVector, 3).AXPY(a, x, y); !got.Equals(want) {
+		t.Errorf("AXPY = %v, want %v", got, want)
+	}
+	xs := NewDualSlice(3).FromVector(x)
+	ys := NewDualSlice(3).FromVector(y)
+	v := make(DualVector, 3)
+	if got := NewDualSlice(3).AXPY(a, xs, ys).ToVector(v); !got.Equals(want) {
+		t.Errorf("DualSlice.AXPY = %v, want %v", got, want)
+	}
+	if got, want := NewDualSlice(3).AddScalar(xs, a).ToVector(v), make(DualVector, 3).AddScalar(x, a); !got.Equals(want) {
+		t.Errorf("DualSlice.AddScalar = %v, want %v", got, want)
+	}
+	if got, want := NewDualSlice(3).Fill(a).ToVector(v), make(DualVector, 3).Fill(a); !got.Equals(want) {
+		t.Errorf("DualSlice.Fill = %v, want %v", got, want)
+	}
+	// The scalar may alias an element of z.
+	z := make(DualVector, 3).Copy(x)
+	if got, want := z.AddScalar(z, &z[0]), (DualVector{{6, 2}, {7, 3}, {3.5, 0}}); !got.Equals(want) {
+		t.Errorf("AddScalar aliased = %v, want %v", got, want)
+	}
+}
+
+func TestBroadcastPanic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("AXPY with mismatched lengths did not panic")
+		}
+	}()
+	make(DualVector, 2).AXPY(NewReal(1, 0), make(DualVector, 2), make(DualVector, 3))
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+// Dualcalc is an interactive calculator for dual numbers.
+//
+// Usage:
+// 		dualcalc [-type real|complex|hamilton] [expression ...]
+// The -type flag selects the numbers to compute with: dual.Real, the default,
+// dual.Complex, or dual.Hamilton, the dual quaternions. With arguments,
+// dualcalc prints the value of each expression and exits. Otherwise it reads
+// one line at a time from the standard input. A line is an expression in the
+// syntax of package eval, such as
+// 		(1+2ε)*(3-ε)/x
+// or an assignment of one to a variable:
+// 		x = 2 + eps
+// The value of the last expression is kept in the variable "ans".
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/meirizarrygelpi/dual/eval"
+)
+
+func main() {
+	typ := flag.String("type", "real", "numbers to compute with: real, complex, or hamilton")
+	flag.Parse()
+	r, prompt := io.Reader(os.Stdin), "> "
+	if flag.NArg() > 0 {
+		r, prompt = strings.NewReader(strings.Join(flag.Args(), "\n")), ""
+	}
+	var ok bool
+	switch *typ {
+	case "real":
+		ok = run(r, os.Stdout, prompt, eval.Eval)
+	case "complex":
+		ok = run(r, os.Stdout, prompt, eval.EvalComplex)
+	case "hamilton":
+		ok = run(r, os.Stdout, prompt, eval.EvalHamilton)
+	default:
+		fmt.Fprintf(os.Stderr, "dualcalc: unknown type %q\n", *typ)
+		os.Exit(2)
+	}
+	if !ok && prompt == "" {
+		os.Exit(1)
+	}
+}
+
+// run reads lines from r, evaluates each one with evaluate, and writes the
+// results to w, with the prompt before each line. It returns false if any line
+// fails.
+func run[T any](r io.Reader, w io.Writer, prompt string, evaluate func(string, map[string]*T) (*T, error)) bool {
+	vars := make(map[string]*T)
+	ok := true
+	s := bufio.NewScanner(r)
+	for fmt.Fprint(w, prompt); s.Scan(); fmt.Fprint(w, prompt) {
+		line := strings.TrimSpace(s.Text())
+		if line == "" {
+			continue
+		}
+		name, src := "ans", line
+		if i := strings.Index(line, "="); i >= 0 {
+			name, src = strings.TrimSpace(line[:i]), line[i+1:]
+			if !isName(name) {
+				fmt.Fprintf(w, "error: invalid variable name %q\n", name)
+				ok = false
+				continue
+			}
+		}
+		v, err := evaluate(src, vars)
+		if err != nil {
+			fmt.Fprintln(w, "error:", err)
+			ok = false
+			continue
+		}
+		vars[name] = v
+		fmt.Fprintln(w, v)
+	}
+	if prompt != "" {
+		fmt.Fprintln(w)
+	}
+	return ok
+}
+
+// isName returns true if s is a variable name: a letter or underscore followed
+// by letters, digits, and underscores.
+func isName(s string) bool {
+	for i, r := range s {
+		if r != '_' && !unicode.IsLetter(r) && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/meirizarrygelpi/dual/eval"
+)
+
+func TestRun(t *testing.T) {
+	in := "x = 2 + eps\n\n(1+2ε)*(3-ε)/x\nans*2\ny\n"
+	var out strings.Builder
+	if run(strings.NewReader(in), &out, "", eval.Eval) {
+		t.Errorf("run succeeded with an undefined variable")
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	want := []string{"(2+1ε)", "(1.5+1.75ε)", "(3+3.5ε)"}
+	if len(lines) != 4 || !strings.HasPrefix(lines[3], "error:") {
+		t.Fatalf("output = %q", lines)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, lines[i], want[i])
+		}
+	}
+}
+
+func TestRunTypes(t *testing.T) {
+	for _, test := range []struct {
+		in   string
+		run  func(string, *strings.Builder) bool
+		want []string
+	}{
+		{
+			"x = 1 + 2i\n1 + x*x*ε",
+			func(in string, out *strings.Builder) bool {
+				return run(strings.NewReader(in), out, "", eval.EvalComplex)
+			},
+			[]string{"(1+2i+0ε+0εi)", "(1+0i-3ε+4εi)"},
+		},
+		{
+			"q = i + εj\nq*k",
+			func(in string, out *strings.Builder) bool {
+				return run(strings.NewReader(in), out, "", eval.EvalHamilton)
+			},
+			[]string{"(0+1i+0j+0k+0ε+0εi+1εj+0εk)", "(0+0i-1j+0k+0ε-1εi+0εj+0εk)"},
+		},
+	} {
+		var out strings.Builder
+		if !test.run(test.in, &out) {
+			t.Errorf("run(%q) failed:\n%s", test.in, out.String())
+			continue
+		}
+		if got := strings.Split(strings.TrimSpace(out.String()), "\n"); strings.Join(got, "|") != strings.Join(test.want, "|") {
+			t.Errorf("run(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestRunNames(t *testing.T) {
+	for _, in := range []string{"1+x = 3", "2x = 1", " = 1", "x y = 2"} {
+		var out strings.Builder
+		if run(strings.NewReader(in), &out, "", eval.Eval) || !strings.HasPrefix(out.String(), "error: invalid variable name") {
+			t.Errorf("run(%q) = %q, want an invalid name error", in, out.String())
+		}
+	}
+	var out strings.Builder
+	if !run(strings.NewReader("_x1 = 2\n_x1*ε"), &out, "", eval.Eval) {
+		t.Errorf("run with the name _x1 failed:\n%s", out.String())
+	}
+}
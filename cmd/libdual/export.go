@@ -0,0 +1,82 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package main
+
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/meirizarrygelpi/dual"
+)
+
+// reals returns the two doubles at p as a slice.
+func reals(p *C.double) []float64 {
+	return unsafe.Slice((*float64)(unsafe.Pointer(p)), 2)
+}
+
+// hamiltons returns the eight doubles at p as a slice.
+func hamiltons(p *C.double) []float64 {
+	return unsafe.Slice((*float64)(unsafe.Pointer(p)), 8)
+}
+
+//export dual_real_add
+func dual_real_add(x, y, z *C.double) {
+	realBinary((*dual.Real).Add, reals(x), reals(y), reals(z))
+}
+
+//export dual_real_sub
+func dual_real_sub(x, y, z *C.double) {
+	realBinary((*dual.Real).Sub, reals(x), reals(y), reals(z))
+}
+
+//export dual_real_mul
+func dual_real_mul(x, y, z *C.double) {
+	realBinary((*dual.Real).Mul, reals(x), reals(y), reals(z))
+}
+
+//export dual_real_quo
+func dual_real_quo(x, y, z *C.double) C.int {
+	return C.int(realQuo(reals(x), reals(y), reals(z)))
+}
+
+//export dual_real_exp
+func dual_real_exp(x, z *C.double) {
+	realUnary((*dual.Real).Exp, reals(x), reals(z))
+}
+
+//export dual_real_sin
+func dual_real_sin(x, z *C.double) {
+	realUnary((*dual.Real).Sin, reals(x), reals(z))
+}
+
+//export dual_real_cos
+func dual_real_cos(x, z *C.double) {
+	realUnary((*dual.Real).Cos, reals(x), reals(z))
+}
+
+//export dual_hamilton_add
+func dual_hamilton_add(x, y, z *C.double) {
+	hamiltonBinary((*dual.Hamilton).Add, hamiltons(x), hamiltons(y), hamiltons(z))
+}
+
+//export dual_hamilton_sub
+func dual_hamilton_sub(x, y, z *C.double) {
+	hamiltonBinary((*dual.Hamilton).Sub, hamiltons(x), hamiltons(y), hamiltons(z))
+}
+
+//export dual_hamilton_mul
+func dual_hamilton_mul(x, y, z *C.double) {
+	hamiltonBinary((*dual.Hamilton).Mul, hamiltons(x), hamiltons(y), hamiltons(z))
+}
+
+//export dual_hamilton_inv
+func dual_hamilton_inv(x, z *C.double) C.int {
+	return C.int(hamiltonInv(hamiltons(x), hamiltons(z)))
+}
+
+//export dual_hamilton_sclerp
+func dual_hamilton_sclerp(x, y *C.double, t C.double, z *C.double) {
+	hamiltonScLERP(hamiltons(x), hamiltons(y), float64(t), hamiltons(z))
+}
@@ -0,0 +1,17 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+// Libdual exports the core operations of package dual as a C shared library,
+// so that C, Python, and other consumers call the same implementations as Go
+// programs. Build it with
+// 		go build -buildmode=c-shared -o libdual.so ./cmd/libdual
+// which also writes the header libdual.h.
+//
+// Values are passed as flat arrays of doubles in the order of Cartesian: two
+// for a Real value a + bε and eight for a Hamilton value. Each function reads
+// its operands from x and y and writes its result to z, which can alias an
+// operand. The division functions return 0 on success and -1 if the divisor
+// is a zero divisor, leaving z unchanged.
+package main
+
+func main() {}
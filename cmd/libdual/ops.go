@@ -0,0 +1,74 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package main
+
+import "github.com/meirizarrygelpi/dual"
+
+// The functions in this file hold the flat-array versions of the operations,
+// apart from cgo, so that they can be tested in Go.
+
+// loadReal returns the Real value in x.
+func loadReal(x []float64) *dual.Real {
+	return dual.NewReal(x[0], x[1])
+}
+
+// storeReal writes y to z.
+func storeReal(z []float64, y *dual.Real) {
+	z[0], z[1] = y.Cartesian()
+}
+
+// loadHamilton returns the Hamilton value in x.
+func loadHamilton(x []float64) *dual.Hamilton {
+	return dual.NewHamilton(x[0], x[1], x[2], x[3], x[4], x[5], x[6], x[7])
+}
+
+// storeHamilton writes y to z.
+func storeHamilton(z []float64, y *dual.Hamilton) {
+	z[0], z[1], z[2], z[3], z[4], z[5], z[6], z[7] = y.Cartesian()
+}
+
+// realBinary applies op to the Real values in x and y, and writes the result
+// to z.
+func realBinary(op func(z, x, y *dual.Real) *dual.Real, x, y, z []float64) {
+	storeReal(z, op(new(dual.Real), loadReal(x), loadReal(y)))
+}
+
+// realUnary applies op to the Real value in x, and writes the result to z.
+func realUnary(op func(z, y *dual.Real) *dual.Real, x, z []float64) {
+	storeReal(z, op(new(dual.Real), loadReal(x)))
+}
+
+// realQuo writes the quotient of the Real values in x and y to z, and returns
+// 0, or -1 if y is a zero divisor.
+func realQuo(x, y, z []float64) int {
+	q, err := new(dual.Real).QuoChecked(loadReal(x), loadReal(y))
+	if err != nil {
+		return -1
+	}
+	storeReal(z, q)
+	return 0
+}
+
+// hamiltonBinary applies op to the Hamilton values in x and y, and writes the
+// result to z.
+func hamiltonBinary(op func(z, x, y *dual.Hamilton) *dual.Hamilton, x, y, z []float64) {
+	storeHamilton(z, op(new(dual.Hamilton), loadHamilton(x), loadHamilton(y)))
+}
+
+// hamiltonInv writes the inverse of the Hamilton value in x to z, and returns
+// 0, or -1 if x is a zero divisor.
+func hamiltonInv(x, z []float64) int {
+	y := loadHamilton(x)
+	if y.IsZeroDiv() {
+		return -1
+	}
+	storeHamilton(z, new(dual.Hamilton).Inv(y))
+	return 0
+}
+
+// hamiltonScLERP writes the screw linear interpolation from the unit dual
+// quaternion in x to the one in y at t to z.
+func hamiltonScLERP(x, y []float64, t float64, z []float64) {
+	storeHamilton(z, new(dual.Hamilton).ScLERP(loadHamilton(x), loadHamilton(y), t))
+}
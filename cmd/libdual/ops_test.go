@@ -0,0 +1,44 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/meirizarrygelpi/dual"
+)
+
+func TestOps(t *testing.T) {
+	x := []float64{1, 2}
+	z := make([]float64, 2)
+	realBinary((*dual.Real).Mul, x, []float64{3, -1}, z)
+	if z[0] != 3 || z[1] != 5 {
+		t.Errorf("mul = %v, want [3 5]", z)
+	}
+	// The result can alias an operand.
+	realBinary((*dual.Real).Add, x, x, x)
+	if x[0] != 2 || x[1] != 4 {
+		t.Errorf("add = %v, want [2 4]", x)
+	}
+	if realQuo(x, []float64{0, 1}, z) != -1 {
+		t.Errorf("quo by a zero divisor did not fail")
+	}
+	if realQuo([]float64{4, 2}, []float64{2, 0}, z) != 0 || z[0] != 2 || z[1] != 1 {
+		t.Errorf("quo = %v, want [2 1]", z)
+	}
+	q := []float64{1, 2, 3, 4, 5, 6, 7, 8}
+	w := make([]float64, 8)
+	if hamiltonInv(q, w) != 0 {
+		t.Fatalf("inv failed")
+	}
+	hamiltonBinary((*dual.Hamilton).Mul, q, w, w)
+	if got := loadHamilton(w); !got.EqualsTol(dual.NewHamilton(1, 0, 0, 0, 0, 0, 0, 0), 1e-12) {
+		t.Errorf("q q⁻¹ = %v, want 1", got)
+	}
+	id := []float64{1, 0, 0, 0, 0, 0, 0, 0}
+	hamiltonScLERP(id, id, 0.5, w)
+	if got := loadHamilton(w); !got.Equals(dual.NewHamilton(1, 0, 0, 0, 0, 0, 0, 0)) {
+		t.Errorf("sclerp = %v, want 1", got)
+	}
+}
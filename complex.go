@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"math"
 	"math/cmplx"
-	"strings"
 )
 
 // A Complex represents a dual complex number as an ordered array of two
@@ -17,47 +16,221 @@ type Complex [2]complex128
 var (
 	// Symbols for the canonical dual complex basis.
 	symbComplex = [4]string{"", "i", "ε", "εi"}
+
+	// ASCII symbols for the canonical dual complex basis.
+	symbComplexASCII = [4]string{"", "i", "eps", "epsi"}
+
+	// LaTeX symbols for the canonical dual complex basis.
+	symbComplexLatex = [4]string{"", "i", `\varepsilon`, `\varepsilon i`}
 )
 
+// Real returns the real part of z, a pointer to a complex128 value. The pointer
+// refers to the real part stored in z.
+func (z *Complex) Real() *complex128 {
+	return &z[0]
+}
+
+// Dual returns the dual part of z, a pointer to a complex128 value. The pointer
+// refers to the dual part stored in z.
+func (z *Complex) Dual() *complex128 {
+	return &z[1]
+}
+
+// SetReal sets the real part of z equal to a.
+func (z *Complex) SetReal(a *complex128) {
+	z[0] = *a
+}
+
+// SetDual sets the dual part of z equal to b.
+func (z *Complex) SetDual(b *complex128) {
+	z[1] = *b
+}
+
+// Cartesian returns the four Cartesian components of z.
+func (z *Complex) Cartesian() (a, b, c, d float64) {
+	a, b = real(z[0]), imag(z[0])
+	c, d = real(z[1]), imag(z[1])
+	return
+}
+
+// components returns the four Cartesian components of z as a slice.
+func (z *Complex) components() []float64 {
+	v := make([]float64, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	return v
+}
+
+// Set sets the four Cartesian components of z, in the order returned by
+// Cartesian, and returns z.
+func (z *Complex) Set(a, b, c, d float64) *Complex {
+	z[0] = complex(a, b)
+	z[1] = complex(c, d)
+	return z
+}
+
+// Polar returns the modulus r, the phase θ, and the dual argument t of z, such
+// that z = r exp(iθ)(1 + tε). The modulus and phase are those of the real part
+// of z, and the dual argument is a complex128 value. If z is a zero divisor,
+// then t is infinite or NaN.
+func (z *Complex) Polar() (r, θ float64, t complex128) {
+	r, θ = cmplx.Polar(z[0])
+	t = z[1] / z[0]
+	return
+}
+
+// ComplexFromPolar returns a pointer to a Complex value made from a given
+// modulus r, phase θ, and dual argument t. This is the inverse of Polar:
+// 		ComplexFromPolar(r, θ, t) = r exp(iθ)(1 + tε)
+func ComplexFromPolar(r, θ float64, t complex128) *Complex {
+	z := new(Complex)
+	z[0] = cmplx.Rect(r, θ)
+	z[1] = z[0] * t
+	return z
+}
+
+// Abs returns the dual modulus of z, a pointer to a Real value. If
+// z = w + vε, then the modulus is
+// 		|w| + (Re(w̄v)/|w|)ε
+// whose dual part is the sensitivity of |w| along v. If z is a zero divisor,
+// then the dual part is NaN.
+func (z *Complex) Abs() *Real {
+	r := cmplx.Abs(z[0])
+	return NewReal(r, real(cmplx.Conj(z[0])*z[1])/r)
+}
+
+// Phase returns the dual phase of z, a pointer to a Real value. If z = w + vε,
+// then the phase is
+// 		arg(w) + (Im(w̄v)/|w|²)ε
+// with arg(w) in [-π, π]. If z is a zero divisor, then the dual part is
+// infinite or NaN.
+func (z *Complex) Phase() *Real {
+	r := cmplx.Abs(z[0])
+	return NewReal(cmplx.Phase(z[0]), imag(cmplx.Conj(z[0])*z[1])/(r*r))
+}
+
+// ComplexFromPolarDual returns a pointer to a Complex value made from a dual
+// modulus r and a dual phase θ. This is the inverse of Abs and Phase:
+// 		ComplexFromPolarDual(z.Abs(), z.Phase()) = z
+// for z not a zero divisor.
+func ComplexFromPolarDual(r, θ *Real) *Complex {
+	z := new(Complex)
+	e := cmplx.Rect(1, θ.Real())
+	z[0] = e * complex(r.Real(), 0)
+	z[1] = e * complex(r.Dual(), r.Real()*θ.Dual())
+	return z
+}
+
 // String returns the string representation of a Complex value.
 //
 // If z corresponds to the dual complex number a + bi + cε + dεi, then the
 // string is "(a+bi+cε+dεi)", similar to complex128 values.
 func (z *Complex) String() string {
-	v := make([]float64, 4)
-	v[0], v[1] = real(z[0]), imag(z[0])
-	v[2], v[3] = real(z[1]), imag(z[1])
-	a := make([]string, 9)
-	a[0] = "("
-	a[1] = fmt.Sprintf("%g", v[0])
-	i := 1
-	for j := 2; j < 8; j = j + 2 {
-		switch {
-		case math.Signbit(v[i]):
-			a[j] = fmt.Sprintf("%g", v[i])
-		case math.IsInf(v[i], +1):
-			a[j] = "+Inf"
-		default:
-			a[j] = fmt.Sprintf("+%g", v[i])
-		}
-		a[j+1] = symbComplex[i]
-		i++
+	return string(z.AppendString(nil))
+}
+
+// AppendString appends the string version of z, as returned by String, to dst
+// and returns the extended buffer.
+func (z *Complex) AppendString(dst []byte) []byte {
+	var v [4]float64
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	return appendComponents(dst, v[:], symbols(symbComplex[:], symbComplexASCII[:]))
+}
+
+// AppendText implements the encoding.TextAppender interface. It appends the
+// string version of z to b, and never returns an error.
+func (z *Complex) AppendText(b []byte) ([]byte, error) {
+	return z.AppendString(b), nil
+}
+
+// MarshalText implements encoding.TextMarshaler; see the package documentation.
+func (z Complex) MarshalText() ([]byte, error) {
+	return z.AppendString(nil), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It sets z
+// equal to the value represented by text, with the syntax of ParseComplex. If text
+// is not valid, then z is left unchanged and the error is returned.
+func (z *Complex) UnmarshalText(text []byte) error {
+	y, err := ParseComplex(string(text))
+	if err != nil {
+		return err
 	}
-	a[8] = ")"
-	return strings.Join(a, "")
+	z.Copy(y)
+	return nil
+}
+
+// Format implements fmt.Formatter; see the package documentation.
+func (z *Complex) Format(s fmt.State, verb rune) {
+	v := z.components()
+	symb := symbols(symbComplex[:], symbComplexASCII[:])
+	formatComponents(s, verb, v, symb, z)
+}
+
+// Latex returns the LaTeX representation of a Complex value.
+//
+// If z corresponds to a + bi + cε + dεi, then the string is
+// "a + bi + c\varepsilon + d\varepsilon i", with negative coefficients written with a minus
+// sign, and exponents written as powers of 10.
+func (z *Complex) Latex() string {
+	v := z.components()
+	return latexComponents(v, symbComplexLatex[:])
+}
+
+// FormatWith returns the string version of a Complex value, formatted with the
+// options o. A nil o prints the same string as String.
+func (z *Complex) FormatWith(o *FormatOptions) string {
+	v := z.components()
+	return o.format(v, symbComplex[:], symbComplexASCII[:], symbComplexLatex[:])
+}
+
+// GoString returns the Go syntax of a Complex value, as a call to NewComplex such as
+// "dual.NewComplex(1, 2, 3, 4)". It is used by the "%#v" verb.
+func (z *Complex) GoString() string {
+	v := z.components()
+	return goCall("dual.NewComplex", v...)
 }
 
-// Equals returns true if z and y are equal.
+// Equals returns true if z and y are equal, within the absolute tolerance
+// Tolerance.
 func (z *Complex) Equals(y *Complex) bool {
-	for i := range z {
-		if notEquals(real(z[i]), real(y[i])) {
-			return false
-		}
-		if notEquals(imag(z[i]), imag(y[i])) {
-			return false
-		}
-	}
-	return true
+	return z.EqualsTol(y, Tolerance)
+}
+
+// EqualsTol returns true if each component of z differs from the matching
+// component of y by at most tol.
+func (z *Complex) EqualsTol(y *Complex, tol float64) bool {
+	return equalsTol(z.components(), y.components(), tol)
+}
+
+// Equal returns true if each component of z has the same bits as the matching
+// component of y. Unlike Equals, it tells 0 from -0, and a NaN is equal to
+// itself.
+func (z *Complex) Equal(y *Complex) bool {
+	return equalBits(z.components(), y.components())
+}
+
+// Hash64 returns a map-key hash of z; see the package documentation.
+func (z *Complex) Hash64(step float64) uint64 {
+	return hash64(z.components(), step)
+}
+
+// Cmp compares z and y in lexicographic order of their components, as given by
+// Cartesian, and returns -1, 0, or +1 as z is less than, equal to, or greater
+// than y. Each pair of components is compared as by cmp.Compare, so 0 equals -0
+// and a NaN is less than any other value and equal to another NaN. This is a
+// total order for sorting and searching, unrelated to the algebra.
+func (z *Complex) Cmp(y *Complex) int {
+	return compare(z.components(), y.components())
+}
+
+// Diff returns how z differs from y; see the package documentation.
+func (z *Complex) Diff(y *Complex) Diff {
+	return diff(z.components(), y.components(), symbols(symbComplex[:], symbComplexASCII[:]))
+}
+
+// EqualsULP compares z and y in ULPs; see the package documentation.
+func (z *Complex) EqualsULP(y *Complex, ulps uint) bool {
+	return equalsULP(z.components(), y.components(), ulps)
 }
 
 // Copy copies y onto z, and returns z.
@@ -113,6 +286,20 @@ func ComplexNaN() *Complex {
 	return z
 }
 
+// IsFinite returns true if every component of z is finite, that is, neither
+// infinite nor NaN.
+func (z *Complex) IsFinite() bool {
+	v := z.components()
+	return isFinite(v)
+}
+
+// Validate returns nil if every component of z is finite, and otherwise a
+// *NonFiniteError that reports the first component that is infinite or NaN.
+func (z *Complex) Validate() error {
+	v := z.components()
+	return validate(v, symbols(symbComplex[:], symbComplexASCII[:]))
+}
+
 // Scal sets z equal to y scaled by a (with a being a complex128), and returns
 // z.
 //
@@ -134,14 +321,16 @@ func (z *Complex) Dil(y *Complex, a float64) *Complex {
 	return z
 }
 
-// Neg sets z equal to the negative of y, and returns z.
+// Neg sets z equal to the negative of y, and returns z. It flips the sign of
+// each component, zeros included.
 func (z *Complex) Neg(y *Complex) *Complex {
 	z[0] = -y[0]
 	z[1] = -y[1]
 	return z
 }
 
-// Conj sets z equal to the conjugate of y, and returns z.
+// Conj sets z equal to the conjugate of y, and returns z. It flips the sign of
+// each component but the first, zeros included.
 func (z *Complex) Conj(y *Complex) *Complex {
 	z[0] = cmplx.Conj(y[0])
 	z[1] = -y[1]
@@ -171,12 +360,33 @@ func (z *Complex) Sub(x, y *Complex) *Complex {
 //      ε * i = i * ε = εi
 //      εi * i = i * εi = -ε
 //      ε * εi = εi * ε = 0
-// This multiplication rule is noncommutative but associative.
+// This multiplication rule is noncommutative but associative. Each part is
+// computed with fused multiply-adds, which avoid the loss of accuracy when
+// terms nearly cancel.
 func (z *Complex) Mul(x, y *Complex) *Complex {
-	p := new(Complex).Copy(x)
-	q := new(Complex).Copy(y)
-	z[0] = p[0] * q[0]
-	z[1] = (p[0] * q[1]) + (p[1] * cmplx.Conj(q[0]))
+	a, b := x[0], x[1]
+	c, d := y[0], y[1]
+	e := addMulFMA(mulFMA(a, d), b, cmplx.Conj(c))
+	if isNaNParts(e) {
+		e = crossTermComplex(a, d) + crossTermComplex(b, cmplx.Conj(c))
+	}
+	z[0] = mulFMA(a, c)
+	z[1] = e
+	return z
+}
+
+// MulAdd sets z equal to x*y + w, and returns z. Each part is computed with
+// fused multiply-adds.
+func (z *Complex) MulAdd(x, y, w *Complex) *Complex {
+	a, b := x[0], x[1]
+	c, d := y[0], y[1]
+	e, f := w[0], w[1]
+	g := addMulFMA(addMulFMA(f, a, d), b, cmplx.Conj(c))
+	if isNaNParts(g) {
+		g = crossTermComplex(a, d) + crossTermComplex(b, cmplx.Conj(c)) + f
+	}
+	z[0] = addMulFMA(e, a, c)
+	z[1] = g
 	return z
 }
 
@@ -188,11 +398,301 @@ func (z *Complex) Commutator(x, y *Complex) *Complex {
 // Quad returns the quadrance of z, a float64 value.
 func (z *Complex) Quad() float64 {
 	a, b := real(z[0]), imag(z[0])
-	return (a * a) + (b * b)
+	return math.FMA(a, a, b*b)
+}
+
+// mulFMA returns the product of x and y, with each part computed with a fused
+// multiply-add.
+func mulFMA(x, y complex128) complex128 {
+	a, b := real(x), imag(x)
+	c, d := real(y), imag(y)
+	return complex(math.FMA(a, c, -b*d), math.FMA(a, d, b*c))
+}
+
+// addMulFMA returns z + xy, with each part computed with fused multiply-adds.
+func addMulFMA(z, x, y complex128) complex128 {
+	a, b := real(x), imag(x)
+	c, d := real(y), imag(y)
+	return complex(
+		math.FMA(a, c, math.FMA(-b, d, real(z))),
+		math.FMA(a, d, math.FMA(b, c, imag(z))),
+	)
 }
 
-// IsZeroDiv returns true if z is a zero divisor. This is equivalent to
-// z being nilpotent (i.e. z² = 0).
+// crossTermComplex returns the product of x and y, or zero if one of them is
+// zero and the other is infinite without a NaN part. It plays the role of
+// crossTerm for the dual part of Complex products.
+func crossTermComplex(x, y complex128) complex128 {
+	if (x == 0 && isInfParts(y)) || (y == 0 && isInfParts(x)) {
+		return 0
+	}
+	return x * y
+}
+
+// isInfParts returns true if a part of x is infinite and neither part is NaN.
+func isInfParts(x complex128) bool {
+	return cmplx.IsInf(x) && !isNaNParts(x)
+}
+
+// isNaNParts returns true if either part of x is NaN. Unlike cmplx.IsNaN, it
+// does not care whether the other part is infinite.
+func isNaNParts(x complex128) bool {
+	return math.IsNaN(real(x)) || math.IsNaN(imag(x))
+}
+
+// IsZeroDiv returns true if z is a zero divisor, that is, if both components
+// of its real part are zero to within the package tolerance. This is
+// equivalent to z being nilpotent (i.e. z² = 0).
 func (z *Complex) IsZeroDiv() bool {
-	return z[0] != complex(0, 0)
+	return !notEquals(real(z[0]), 0) && !notEquals(imag(z[0]), 0)
+}
+
+// Cond returns a condition estimate for division by z: the ratio of the
+// Euclidean norm of all four components of z to that of its real part. As with
+// Real.Cond, it is +Inf for a zero real part.
+func (z *Complex) Cond() float64 {
+	var v [4]float64
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	return condition(v[:], 2)
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
+// then Inv panics.
+//
+// If y = c + dε, then the inverse is
+// 		1/c - ((1/c)d/c*)ε
+// with c* the conjugate of c. Each complex128 division uses Smith's scaled
+// algorithm, so the result does not overflow or underflow unless the inverse
+// itself does.
+func (z *Complex) Inv(y *Complex) *Complex {
+	if y.IsZeroDiv() {
+		panic("zero divisor")
+	}
+	c, d := y[0], y[1]
+	u := 1 / c
+	z[0] = u
+	z[1] = -(u * d) / cmplx.Conj(c)
+	return z
+}
+
+// Quo sets z equal to the quotient of x and y, that is, x times the inverse of
+// y, and returns z. If y is a zero divisor, then Quo panics.
+//
+// If x = a + bε and y = c + dε, then the quotient is
+// 		a/c + ((b - (a/c)d)/c*)ε
+// with each complex128 division computed as in Inv.
+func (z *Complex) Quo(x, y *Complex) *Complex {
+	if y.IsZeroDiv() {
+		panic("zero divisor denominator")
+	}
+	a, b := x[0], x[1]
+	c, d := y[0], y[1]
+	q := a / c
+	z[0] = q
+	z[1] = (b - (q * d)) / cmplx.Conj(c)
+	return z
+}
+
+// InvChecked sets z equal to the inverse of y, and returns z and a nil error.
+// If y is a zero divisor, then z is left unchanged and ErrZeroDivisor is
+// returned in place of the panic of Inv.
+func (z *Complex) InvChecked(y *Complex) (*Complex, error) {
+	if y.IsZeroDiv() {
+		return z, ErrZeroDivisor
+	}
+	return z.Inv(y), nil
+}
+
+// QuoChecked sets z equal to the quotient of x and y, and returns z and a nil
+// error. If y is a zero divisor, then z is left unchanged and ErrZeroDivisor is
+// returned in place of the panic of Quo.
+func (z *Complex) QuoChecked(x, y *Complex) (*Complex, error) {
+	if y.IsZeroDiv() {
+		return z, ErrZeroDivisor
+	}
+	return z.Quo(x, y), nil
+}
+
+// InvNaN sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then every component of z is set to NaN in place of the panic of
+// Inv, so that the failure travels with the value, as with float64 division.
+func (z *Complex) InvNaN(y *Complex) *Complex {
+	if y.IsZeroDiv() {
+		return z.Copy(ComplexNaN())
+	}
+	return z.Inv(y)
+}
+
+// QuoNaN sets z equal to the quotient of x and y, and returns z. If y is a zero
+// divisor, then every component of z is set to NaN in place of the panic of
+// Quo.
+func (z *Complex) QuoNaN(x, y *Complex) *Complex {
+	if y.IsZeroDiv() {
+		return z.Copy(ComplexNaN())
+	}
+	return z.Quo(x, y)
+}
+
+// apply sets z equal to the dual extension of f at y, and returns z, with df
+// the derivative of f. For y = c + dε, the dual part is the divided difference
+// d(f(c) - f(c̄))/(c - c̄), or d·df(c) if c is real.
+func (z *Complex) apply(y *Complex, f, df func(complex128) complex128) *Complex {
+	c, d := y[0], y[1]
+	if imag(c) == 0 {
+		z[1] = d * df(c)
+	} else {
+		b := cmplx.Conj(c)
+		z[1] = d * ((f(c) - f(b)) / (c - b))
+	}
+	z[0] = f(c)
+	return z
+}
+
+// Sin sets z equal to the dual sine of y, and returns z. Since εc = c̄ε in
+// Mul, the power series of sine gives, for y = c + dε,
+// 		sin(c + dε) = sin(c) + d((sin(c) - sin(c̄))/(c - c̄))ε
+// which is sin(c) + d·cos(c)ε when c is real. The functions from Cos to Atanh
+// below follow the same rule, so they agree with Mul and Inv.
+func (z *Complex) Sin(y *Complex) *Complex {
+	return z.apply(y, cmplx.Sin, cmplx.Cos)
+}
+
+// Cos sets z equal to the dual cosine of y, and returns z. The dual part is
+// d(cos(c) - cos(c̄))/(c - c̄), or -d·sin(c) when c is real.
+func (z *Complex) Cos(y *Complex) *Complex {
+	return z.apply(y, cmplx.Cos, func(c complex128) complex128 {
+		return -cmplx.Sin(c)
+	})
+}
+
+// Tan sets z equal to the dual tangent of y, and returns z. The dual part is
+// d(tan(c) - tan(c̄))/(c - c̄), or d(1 + tan²(c)) when c is real.
+func (z *Complex) Tan(y *Complex) *Complex {
+	return z.apply(y, cmplx.Tan, func(c complex128) complex128 {
+		t := cmplx.Tan(c)
+		return 1 + t*t
+	})
+}
+
+// Asin sets z equal to the dual inverse sine of y, and returns z. The dual part
+// is d(asin(c) - asin(c̄))/(c - c̄), or d/√(1 - c²) when c is real. The real
+// part follows the branch cuts of cmplx.Asin.
+func (z *Complex) Asin(y *Complex) *Complex {
+	return z.apply(y, cmplx.Asin, func(c complex128) complex128 {
+		return 1 / cmplx.Sqrt(1-c*c)
+	})
+}
+
+// Acos sets z equal to the dual inverse cosine of y, and returns z. The dual
+// part is d(acos(c) - acos(c̄))/(c - c̄), or -d/√(1 - c²) when c is real. The
+// real part follows the branch cuts of cmplx.Acos.
+func (z *Complex) Acos(y *Complex) *Complex {
+	return z.apply(y, cmplx.Acos, func(c complex128) complex128 {
+		return -1 / cmplx.Sqrt(1-c*c)
+	})
+}
+
+// Atan sets z equal to the dual inverse tangent of y, and returns z. The dual
+// part is d(atan(c) - atan(c̄))/(c - c̄), or d/(1 + c²) when c is real. The
+// real part follows the branch cuts of cmplx.Atan.
+func (z *Complex) Atan(y *Complex) *Complex {
+	return z.apply(y, cmplx.Atan, func(c complex128) complex128 {
+		return 1 / (1 + c*c)
+	})
+}
+
+// Sinh sets z equal to the dual hyperbolic sine of y, and returns z. The dual
+// part is d(sinh(c) - sinh(c̄))/(c - c̄), or d·cosh(c) when c is real.
+func (z *Complex) Sinh(y *Complex) *Complex {
+	return z.apply(y, cmplx.Sinh, cmplx.Cosh)
+}
+
+// Cosh sets z equal to the dual hyperbolic cosine of y, and returns z. The dual
+// part is d(cosh(c) - cosh(c̄))/(c - c̄), or d·sinh(c) when c is real.
+func (z *Complex) Cosh(y *Complex) *Complex {
+	return z.apply(y, cmplx.Cosh, cmplx.Sinh)
+}
+
+// Tanh sets z equal to the dual hyperbolic tangent of y, and returns z. The
+// dual part is d(tanh(c) - tanh(c̄))/(c - c̄), or d(1 - tanh²(c)) when c is
+// real.
+func (z *Complex) Tanh(y *Complex) *Complex {
+	return z.apply(y, cmplx.Tanh, func(c complex128) complex128 {
+		t := cmplx.Tanh(c)
+		return 1 - t*t
+	})
+}
+
+// Asinh sets z equal to the dual inverse hyperbolic sine of y, and returns z.
+// The dual part is d(asinh(c) - asinh(c̄))/(c - c̄), or d/√(1 + c²) when c is
+// real. The real part follows the branch cuts of cmplx.Asinh.
+func (z *Complex) Asinh(y *Complex) *Complex {
+	return z.apply(y, cmplx.Asinh, func(c complex128) complex128 {
+		return 1 / cmplx.Sqrt(1+c*c)
+	})
+}
+
+// Acosh sets z equal to the dual inverse hyperbolic cosine of y, and returns z.
+// The dual part is d(acosh(c) - acosh(c̄))/(c - c̄), or d/(√(c - 1)√(c + 1))
+// when c is real. The real part follows the branch cuts of cmplx.Acosh, and
+// the square roots of the derivative are taken separately to match them.
+func (z *Complex) Acosh(y *Complex) *Complex {
+	return z.apply(y, cmplx.Acosh, func(c complex128) complex128 {
+		return 1 / (cmplx.Sqrt(c-1) * cmplx.Sqrt(c+1))
+	})
+}
+
+// Atanh sets z equal to the dual inverse hyperbolic tangent of y, and returns
+// z. The dual part is d(atanh(c) - atanh(c̄))/(c - c̄), or d/(1 - c²) when c
+// is real. The real part follows the branch cuts of cmplx.Atanh.
+func (z *Complex) Atanh(y *Complex) *Complex {
+	return z.apply(y, cmplx.Atanh, func(c complex128) complex128 {
+		return 1 / (1 - c*c)
+	})
+}
+
+// The following functions provide a value-semantics alternative to the methods
+// of Complex. They do not modify any of their arguments, and return a new value. Complex
+// values are comparable, so they can also be used as map keys.
+
+// ComplexNeg returns the negative of x.
+func ComplexNeg(x Complex) Complex {
+	var z Complex
+	z.Neg(&x)
+	return z
+}
+
+// ComplexConj returns the conjugate of x.
+func ComplexConj(x Complex) Complex {
+	var z Complex
+	z.Conj(&x)
+	return z
+}
+
+// ComplexScal returns x scaled by a.
+func ComplexScal(x Complex, a complex128) Complex {
+	var z Complex
+	z.Scal(&x, a)
+	return z
+}
+
+// ComplexAdd returns the sum of x and y.
+func ComplexAdd(x, y Complex) Complex {
+	var z Complex
+	z.Add(&x, &y)
+	return z
+}
+
+// ComplexSub returns the difference of x and y.
+func ComplexSub(x, y Complex) Complex {
+	var z Complex
+	z.Sub(&x, &y)
+	return z
+}
+
+// ComplexMul returns the product of x and y.
+func ComplexMul(x, y Complex) Complex {
+	var z Complex
+	z.Mul(&x, &y)
+	return z
 }
@@ -0,0 +1,198 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestComplexIsZeroDiv(t *testing.T) {
+	var tests = []struct {
+		z    *Complex
+		want bool
+	}{
+		{NewComplex(0, 0, 0, 0), true},
+		{NewComplex(1, 0, 0, 0), false},
+		{NewComplex(0, 1, 0, 0), false},
+		{NewComplex(0, 0, 1, 1), true},
+		{NewComplex(1e-17, -1e-12, 3, 4), true},
+	}
+	for _, test := range tests {
+		if got := test.z.IsZeroDiv(); got != test.want {
+			t.Errorf("IsZeroDiv(%v) = %v", test.z, got)
+		}
+	}
+}
+
+func TestComplexAbsPhase(t *testing.T) {
+	var tests = []struct {
+		z          *Complex
+		abs, phase *Real
+	}{
+		{NewComplex(3, 4, 0, 0), NewReal(5, 0), NewReal(math.Atan2(4, 3), 0)},
+		{NewComplex(3, 4, 3, 4), NewReal(5, 5), NewReal(math.Atan2(4, 3), 0)},
+		{NewComplex(0, 2, 1, 0), NewReal(2, 0), NewReal(math.Pi/2, -0.5)},
+	}
+	for _, test := range tests {
+		r, θ := test.z.Abs(), test.z.Phase()
+		if !r.Equals(test.abs) || !θ.Equals(test.phase) {
+			t.Errorf("Abs, Phase(%v) = %v, %v, want %v, %v",
+				test.z, r, θ, test.abs, test.phase)
+		}
+		if got := ComplexFromPolarDual(r, θ); !got.Equals(test.z) {
+			t.Errorf("ComplexFromPolarDual(%v, %v) = %v, want %v",
+				r, θ, got, test.z)
+		}
+	}
+}
+
+func TestComplexPolar(t *testing.T) {
+	for _, z := range []*Complex{
+		NewComplex(1, 2, -3, 0.5),
+		NewComplex(-4, 0, 0, 1),
+		NewComplex(0, -0.25, 2, 2),
+	} {
+		r, θ, u := z.Polar()
+		if r != cmplx.Abs(z[0]) || θ != cmplx.Phase(z[0]) {
+			t.Errorf("Polar(%v) = %v, %v, want the modulus and phase of %v", z, r, θ, z[0])
+		}
+		if got := ComplexFromPolar(r, θ, u); !got.EqualsTol(z, 1e-12) {
+			t.Errorf("ComplexFromPolar(Polar(%v)) = %v", z, got)
+		}
+	}
+	r, θ, u := ComplexFromPolar(2, -0.7, complex(0.3, -1.1)).Polar()
+	if math.Abs(r-2) > 1e-12 || math.Abs(θ+0.7) > 1e-12 || cmplx.Abs(u-complex(0.3, -1.1)) > 1e-12 {
+		t.Errorf("Polar(ComplexFromPolar(2, -0.7, 0.3-1.1i)) = %v, %v, %v", r, θ, u)
+	}
+	// A zero divisor has no dual argument, and a zero modulus gives zero.
+	for _, z := range []*Complex{NewComplex(0, 0, 1, 2), NewComplex(0, 0, 0, 0)} {
+		if r, _, u := z.Polar(); r != 0 || !(cmplx.IsInf(u) || cmplx.IsNaN(u)) {
+			t.Errorf("Polar(%v) = %v, _, %v, want 0, _, Inf or NaN", z, r, u)
+		}
+	}
+	if z := ComplexFromPolar(0, 1, complex(3, 4)); !z.Equals(new(Complex)) || !z.IsZeroDiv() {
+		t.Errorf("ComplexFromPolar(0, 1, 3+4i) = %v, want 0", z)
+	}
+}
+
+// complexSeries returns the sum of a(n)yⁿ for n from 0 through 39, with the
+// powers of y formed by Mul.
+func complexSeries(y *Complex, a func(n int) float64) *Complex {
+	sum, p := new(Complex), NewComplex(1, 0, 0, 0)
+	var term Complex
+	for n := 0; n < 40; n++ {
+		sum.Add(sum, term.Scal(p, complex(a(n), 0)))
+		p.Mul(p, y)
+	}
+	return sum
+}
+
+func TestComplexFunctions(t *testing.T) {
+	const tol = 1e-12
+	y := &Complex{complex(0.3, 0.7), complex(0.2, -0.1)}
+	fact := func(n int) float64 { return math.Gamma(float64(n + 1)) }
+	// The Taylor series of each function, built from Mul, is the reference.
+	for _, test := range []struct {
+		name string
+		f    func(z, y *Complex) *Complex
+		a    func(n int) float64
+	}{
+		{"Sin", (*Complex).Sin, func(n int) float64 {
+			if n%2 == 0 {
+				return 0
+			}
+			return math.Pow(-1, float64(n/2)) / fact(n)
+		}},
+		{"Cos", (*Complex).Cos, func(n int) float64 {
+			if n%2 == 1 {
+				return 0
+			}
+			return math.Pow(-1, float64(n/2)) / fact(n)
+		}},
+		{"Sinh", (*Complex).Sinh, func(n int) float64 {
+			if n%2 == 0 {
+				return 0
+			}
+			return 1 / fact(n)
+		}},
+		{"Cosh", (*Complex).Cosh, func(n int) float64 {
+			if n%2 == 1 {
+				return 0
+			}
+			return 1 / fact(n)
+		}},
+	} {
+		want := complexSeries(y, test.a)
+		if got := test.f(new(Complex), y); !got.EqualsTol(want, tol) {
+			t.Errorf("%s(%v) = %v, want the series %v", test.name, y, got, want)
+		}
+	}
+	if got, want := new(Complex).Sin(y)[1], complex(0.2071, -0.1035); cmplx.Abs(got-want) > 1e-4 {
+		t.Errorf("Sin(%v) has dual part %v, want %v", y, got, want)
+	}
+	// Quotients and inverses must agree with Mul and Inv.
+	sin, cos := new(Complex).Sin(y), new(Complex).Cos(y)
+	if got, want := new(Complex).Tan(y), new(Complex).Mul(sin, new(Complex).Inv(cos)); !got.EqualsTol(want, tol) {
+		t.Errorf("Tan(%v) = %v, want Sin·Inv(Cos) = %v", y, got, want)
+	}
+	sinh, cosh := new(Complex).Sinh(y), new(Complex).Cosh(y)
+	if got, want := new(Complex).Tanh(y), new(Complex).Mul(sinh, new(Complex).Inv(cosh)); !got.EqualsTol(want, tol) {
+		t.Errorf("Tanh(%v) = %v, want Sinh·Inv(Cosh) = %v", y, got, want)
+	}
+	for _, test := range []struct {
+		name    string
+		f, finv func(z, y *Complex) *Complex
+	}{
+		{"Sin∘Asin", (*Complex).Sin, (*Complex).Asin},
+		{"Cos∘Acos", (*Complex).Cos, (*Complex).Acos},
+		{"Tan∘Atan", (*Complex).Tan, (*Complex).Atan},
+		{"Sinh∘Asinh", (*Complex).Sinh, (*Complex).Asinh},
+		{"Cosh∘Acosh", (*Complex).Cosh, (*Complex).Acosh},
+		{"Tanh∘Atanh", (*Complex).Tanh, (*Complex).Atanh},
+	} {
+		if got := test.f(new(Complex), test.finv(new(Complex), y)); !got.EqualsTol(y, tol) {
+			t.Errorf("%s(%v) = %v", test.name, y, got)
+		}
+	}
+	// With a real c, the dual part is d·f′(c), checked against a central
+	// difference, and the real part is that of math/cmplx.
+	const h = 1e-6
+	d := complex(2, -1)
+	for _, test := range []struct {
+		name string
+		f    func(z, y *Complex) *Complex
+		g    func(complex128) complex128
+		c    float64
+	}{
+		{"Sin", (*Complex).Sin, cmplx.Sin, 0.6},
+		{"Cos", (*Complex).Cos, cmplx.Cos, 0.6},
+		{"Tan", (*Complex).Tan, cmplx.Tan, 0.6},
+		{"Asin", (*Complex).Asin, cmplx.Asin, 0.6},
+		{"Acos", (*Complex).Acos, cmplx.Acos, 0.6},
+		{"Atan", (*Complex).Atan, cmplx.Atan, 0.6},
+		{"Sinh", (*Complex).Sinh, cmplx.Sinh, 0.6},
+		{"Cosh", (*Complex).Cosh, cmplx.Cosh, 0.6},
+		{"Tanh", (*Complex).Tanh, cmplx.Tanh, 0.6},
+		{"Asinh", (*Complex).Asinh, cmplx.Asinh, 0.6},
+		{"Acosh", (*Complex).Acosh, cmplx.Acosh, 1.6},
+		{"Atanh", (*Complex).Atanh, cmplx.Atanh, 0.6},
+	} {
+		c := complex(test.c, 0)
+		x := &Complex{c, d}
+		got := test.f(new(Complex), x)
+		if got[0] != test.g(c) {
+			t.Errorf("%s(%v) has real part %v, want %v", test.name, x, got[0], test.g(c))
+		}
+		fd := d * (test.g(c+h) - test.g(c-h)) / (2 * h)
+		if cmplx.Abs(got[1]-fd) > 1e-6 {
+			t.Errorf("%s(%v) has dual part %v, want %v", test.name, x, got[1], fd)
+		}
+		w := *y
+		if z := test.f(&w, &w); *z != *test.f(new(Complex), y) {
+			t.Errorf("%s in place = %v, want %v", test.name, z, test.f(new(Complex), y))
+		}
+	}
+}
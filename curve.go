@@ -0,0 +1,141 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "math"
+
+// The curve methods evaluate a Bézier curve or a B-spline curve with scalar
+// control points at a dual parameter. At t + ε, a Real result is the value of
+// the curve plus its derivative times ε. At t + ε + η, a Hyper result also
+// carries the second derivative in its εη component. A curve in space is
+// evaluated one coordinate at a time, as in BezierVec3 and BezierCurvature.
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// t, by the de Casteljau algorithm, and returns z. If p is empty, then Bezier
+// panics.
+func (z *Real) Bezier(p []float64, t *Real) *Real {
+	b := make([]Real, len(p))
+	for i := range p {
+		b[i].Set(p[i], 0)
+	}
+	var d Real
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			d.Sub(&b[i+1], &b[i])
+			b[i].Add(&b[i], d.Mul(t, &d))
+		}
+	}
+	return z.Copy(&b[0])
+}
+
+// Bezier sets z equal to the Bézier curve with control points p evaluated at
+// t, by the de Casteljau algorithm, and returns z. If p is empty, then Bezier
+// panics.
+func (z *Hyper) Bezier(p []float64, t *Hyper) *Hyper {
+	b := make([]Hyper, len(p))
+	for i := range p {
+		b[i] = Hyper{p[i]}
+	}
+	var d Hyper
+	for n := len(b) - 1; n > 0; n-- {
+		for i := 0; i < n; i++ {
+			d.Sub(&b[i+1], &b[i])
+			b[i].Add(&b[i], d.Mul(t, &d))
+		}
+	}
+	*z = b[0]
+	return z
+}
+
+// span returns the index s of the knot span [u[s], u[s+1]) of the B-spline of
+// degree k with knots u and n control points that contains t. A t outside the
+// domain [u[k], u[n]] uses the first or last span. If the number of knots is
+// not n+k+1, then span panics.
+func span(u []float64, n, k int, t float64) int {
+	if len(u) != n+k+1 || k < 0 || n <= k {
+		panic("knot vector length")
+	}
+	s := k
+	for s < n-1 && t >= u[s+1] {
+		s++
+	}
+	return s
+}
+
+// BSpline sets z equal to the B-spline curve of degree k with knots u and
+// control points p evaluated at t, by the de Boor algorithm, and returns z.
+// There must be len(p)+k+1 knots, and otherwise BSpline panics.
+func (z *Real) BSpline(u, p []float64, k int, t *Real) *Real {
+	s := span(u, len(p), k, t.Real())
+	d := make([]Real, k+1)
+	for j := range d {
+		d[j].Set(p[j+s-k], 0)
+	}
+	var a, e Real
+	for r := 1; r <= k; r++ {
+		for j := k; j >= r; j-- {
+			i := j + s - k
+			a.Sub(t, NewReal(u[i], 0))
+			a.Scal(&a, 1/(u[i+k+1-r]-u[i]))
+			e.Sub(&d[j], &d[j-1])
+			d[j].Add(&d[j-1], e.Mul(&a, &e))
+		}
+	}
+	return z.Copy(&d[k])
+}
+
+// BSpline sets z equal to the B-spline curve of degree k with knots u and
+// control points p evaluated at t, by the de Boor algorithm, and returns z.
+// There must be len(p)+k+1 knots, and otherwise BSpline panics.
+func (z *Hyper) BSpline(u, p []float64, k int, t *Hyper) *Hyper {
+	s := span(u, len(p), k, t[0])
+	d := make([]Hyper, k+1)
+	for j := range d {
+		d[j] = Hyper{p[j+s-k]}
+	}
+	var a, e Hyper
+	for r := 1; r <= k; r++ {
+		for j := k; j >= r; j-- {
+			i := j + s - k
+			a.Sub(t, &Hyper{u[i]})
+			a.Dil(&a, 1/(u[i+k+1-r]-u[i]))
+			e.Sub(&d[j], &d[j-1])
+			d[j].Add(&d[j-1], e.Mul(&a, &e))
+		}
+	}
+	*z = d[k]
+	return z
+}
+
+// BezierVec3 returns the point and the tangent of the Bézier curve in space
+// with control points p at the parameter t, as the dual 3-vector r(t) + εr'(t).
+func BezierVec3(p [][3]float64, t float64) *DualVec3 {
+	z := new(DualVec3)
+	c := make([]float64, len(p))
+	for i := range z {
+		for j := range p {
+			c[j] = p[j][i]
+		}
+		z[i].Bezier(c, NewReal(t, 1))
+	}
+	return z
+}
+
+// BezierCurvature returns the curvature of the Bézier curve in space with
+// control points p at the parameter t:
+// 		κ = |r' × r''| / |r'|³
+// The derivatives come from a single Hyper evaluation of each coordinate.
+func BezierCurvature(p [][3]float64, t float64) float64 {
+	var d1, d2 [3]float64
+	c := make([]float64, len(p))
+	for i := range d1 {
+		for j := range p {
+			c[j] = p[j][i]
+		}
+		h := new(Hyper).Bezier(c, &Hyper{t, 1, 1, 0})
+		d1[i], d2[i] = h[1], h[3]
+	}
+	s := norm3(d1)
+	return norm3(cross3(d1, d2)) / math.Pow(s, 3)
+}
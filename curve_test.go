@@ -0,0 +1,71 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBezier(t *testing.T) {
+	p := []float64{1, -2, 4, 3}
+	// The cubic in power form, with its first and second derivatives.
+	f := func(s float64) (float64, float64, float64) {
+		u := 1 - s
+		b := u*u*u*p[0] + 3*u*u*s*p[1] + 3*u*s*s*p[2] + s*s*s*p[3]
+		d := 3 * (u*u*(p[1]-p[0]) + 2*u*s*(p[2]-p[1]) + s*s*(p[3]-p[2]))
+		dd := 6 * (u*(p[2]-2*p[1]+p[0]) + s*(p[3]-2*p[2]+p[1]))
+		return b, d, dd
+	}
+	for _, s := range []float64{0, 0.25, 0.6, 1} {
+		b, d, dd := f(s)
+		if got, want := new(Real).Bezier(p, NewReal(s, 1)), NewReal(b, d); !got.EqualsTol(want, 1e-12) {
+			t.Errorf("Bezier(%v) = %v, want %v", s, got, want)
+		}
+		if got, want := new(Hyper).Bezier(p, &Hyper{s, 1, 1, 0}), (&Hyper{b, d, d, dd}); !got.EqualsTol(want, 1e-12) {
+			t.Errorf("Hyper Bezier(%v) = %v, want %v", s, got, want)
+		}
+		// A clamped B-spline with no interior knots is the Bézier curve.
+		u := []float64{0, 0, 0, 0, 1, 1, 1, 1}
+		if got, want := new(Real).BSpline(u, p, 3, NewReal(s, 1)), NewReal(b, d); !got.EqualsTol(want, 1e-12) {
+			t.Errorf("BSpline(%v) = %v, want %v", s, got, want)
+		}
+		if got, want := new(Hyper).BSpline(u, p, 3, &Hyper{s, 1, 1, 0}), (&Hyper{b, d, d, dd}); !got.EqualsTol(want, 1e-12) {
+			t.Errorf("Hyper BSpline(%v) = %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestBSpline(t *testing.T) {
+	// A uniform quadratic B-spline on the span [2, 3), where the basis
+	// functions in x = t - 2 are (1-x)²/2, (-2x²+2x+1)/2, and x²/2.
+	u := []float64{0, 1, 2, 3, 4, 5}
+	p := []float64{0, 1, 4}
+	for _, s := range []float64{2, 2.5, 2.9} {
+		x := s - 2
+		b := ((1-x)*(1-x)*p[0] + (-2*x*x+2*x+1)*p[1] + x*x*p[2]) / 2
+		d := (-2*(1-x)*p[0] + (-4*x+2)*p[1] + 2*x*p[2]) / 2
+		if got, want := new(Real).BSpline(u, p, 2, NewReal(s, 1)), NewReal(b, d); !got.EqualsTol(want, 1e-12) {
+			t.Errorf("BSpline(%v) = %v, want %v", s, got, want)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("BSpline with a short knot vector did not panic")
+		}
+	}()
+	new(Real).BSpline(u[1:], p, 2, NewReal(2, 1))
+}
+
+func TestBezierVec3(t *testing.T) {
+	// A parabola with its vertex at t = ½, where its curvature is 2.
+	p := [][3]float64{{-1, 1, 0}, {0, -1, 0}, {1, 1, 0}}
+	r := BezierVec3(p, 0.5)
+	if got, want := r, NewDualVec3([3]float64{0, 0, 0}, [3]float64{2, 0, 0}); !got.Equals(want) {
+		t.Errorf("BezierVec3 = %v, want %v", got, want)
+	}
+	if κ := BezierCurvature(p, 0.5); math.Abs(κ-2) > 1e-12 {
+		t.Errorf("BezierCurvature = %v, want 2", κ)
+	}
+}
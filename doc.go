@@ -2,10 +2,283 @@
 // Licenced under the MIT License.
 
 // Package dual implements dual number arithmetic.
+//
+// As in the math/big package, the arithmetic methods have the form
+// z.Op(x, y), set z to the result, and return z. The result z may be one of
+// the operands, so that z.Mul(z, y) and z.Mul(x, z) multiply in place. The
+// types made of arrays, like Real, Complex, Hamilton, Super, Hyper, and Ultra,
+// do so without allocating, while Perplex, which is made of pointers,
+// allocates its results.
+//
+// Infinities and NaNs follow IEEE 754 within each component, and the parts
+// that are complex128 or quat.Hamilton values follow Go's arithmetic for those
+// types. Add and Sub work component by component. In a product, the real part
+// is the product of the real parts, so Inf·0 is NaN there. The terms that make
+// up the dual part are left out when one of their factors is exactly zero, so
+// that a number with no dual part does not bring 0·Inf into it:
+// 		(Inf + 0ε)(2 + 0ε) = Inf + 0ε
+// 		(1 + 0ε)/(Inf + 0ε) = 0 + 0ε
+// A value is infinite if any component is infinite, and NaN if it has a NaN
+// component and no infinite one, as reported by IsInf and IsNaN.
+//
+// Each type implements fmt.Formatter. The floating-point verbs 'e', 'E', 'f',
+// 'F', 'g', and 'G' are applied to each component, together with the width,
+// precision, and flags, so that "%.3f" or "%10.4e" give aligned output. The
+// verbs 'v' and 's' behave like 'g', and with no flags give the same string as
+// String.
+//
+// Each type also implements encoding.TextMarshaler, so that a value is written
+// as its string version by encoders such as encoding/json and YAML packages.
+// MarshalText has a value receiver, so that a value held by value in a struct,
+// slice, or map is written as text too, and it never returns an error.
+//
+// The EqualsULP methods return true if each component of one value is at most
+// a given number of units in the last place (ULPs) away from the matching
+// component of another. Unlike Equals, the comparison scales with the
+// magnitude of the components. NaN components are never equal.
+//
+// The Diff methods return the components in which one value differs from
+// another, with the absolute and ULP distance of each, to explain why a
+// comparison against an expected value failed. The result is empty if the
+// values are Equal.
+//
+// The Hash64 methods return a 64-bit hash of the components of a value, for
+// use as a map key. With a step of zero they hash the exact bits, so values
+// that are Equal have the same hash, while values that only Equals reports as
+// equal may hash differently. A positive step rounds each component to the
+// nearest multiple of step before hashing, which merges nearby values, but two
+// values on either side of a rounding boundary still differ however close they
+// are.
 package dual
 
+import (
+	"cmp"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrZeroDivisor is the error returned by the checked division methods, such
+// as InvChecked and QuoChecked, when the divisor is a zero divisor.
+var ErrZeroDivisor = errors.New("dual: zero divisor")
+
+// A NonFiniteError is the error returned by the Validate methods. It reports
+// the first component of a value that is infinite or NaN.
+type NonFiniteError struct {
+	Index  int     // position of the component, in the order of Cartesian
+	Symbol string  // basis symbol of the component, empty for the first
+	Value  float64 // the infinite or NaN component
+}
+
+// Error returns a message such as "dual: component 2 (ε) is NaN".
+func (e *NonFiniteError) Error() string {
+	s := "dual: component " + strconv.Itoa(e.Index)
+	if e.Symbol != "" {
+		s += " (" + e.Symbol + ")"
+	}
+	return s + " is " + strconv.FormatFloat(e.Value, 'g', -1, 64)
+}
+
+// A ComponentDiff describes a component in which two values differ.
+type ComponentDiff struct {
+	Index     int     // position of the component, in the order of Cartesian
+	Symbol    string  // basis symbol of the component, empty for the first
+	Got, Want float64 // the components of the receiver and of the argument
+	Abs       float64 // absolute difference, NaN if either component is NaN
+	ULPs      uint64  // units in the last place apart, MaxUint64 for a NaN
+}
+
+// A Diff is the list returned by the Diff methods, of the components in which
+// two values differ. It is empty if and only if the values are Equal.
+type Diff []ComponentDiff
+
+// String returns a description of d such as
+// "component 2 (ε): got 1.5, want 1.25, off by 0.25 (4503599627370496 ULPs)",
+// with one line per component, or "no difference" if d is empty.
+func (d Diff) String() string {
+	if len(d) == 0 {
+		return "no difference"
+	}
+	a := make([]string, len(d))
+	for i, c := range d {
+		s := "component " + strconv.Itoa(c.Index)
+		if c.Symbol != "" {
+			s += " (" + c.Symbol + ")"
+		}
+		a[i] = s + ": got " + strconv.FormatFloat(c.Got, 'g', -1, 64) +
+			", want " + strconv.FormatFloat(c.Want, 'g', -1, 64) +
+			", off by " + strconv.FormatFloat(c.Abs, 'g', 3, 64) +
+			" (" + strconv.FormatUint(c.ULPs, 10) + " ULPs)"
+	}
+	return strings.Join(a, "\n")
+}
+
+// diff returns the elements of v whose bits differ from those of the matching
+// elements of w, with symb the basis symbols.
+func diff(v, w []float64, symb []string) Diff {
+	var d Diff
+	for i := range v {
+		if math.Float64bits(v[i]) == math.Float64bits(w[i]) {
+			continue
+		}
+		c := ComponentDiff{Index: i, Symbol: symb[i], Got: v[i], Want: w[i]}
+		c.Abs = math.Abs(v[i] - w[i])
+		if math.IsNaN(v[i]) || math.IsNaN(w[i]) {
+			c.Abs, c.ULPs = math.NaN(), math.MaxUint64
+		} else {
+			a, b := ordered(v[i]), ordered(w[i])
+			if a < b {
+				a, b = b, a
+			}
+			c.ULPs = uint64(a) - uint64(b)
+		}
+		d = append(d, c)
+	}
+	return d
+}
+
+// isFinite returns true if no element of v is infinite or NaN.
+func isFinite(v []float64) bool {
+	for _, x := range v {
+		if math.IsInf(x, 0) || math.IsNaN(x) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasNaN returns true if any element of v is NaN.
+func hasNaN(v ...float64) bool {
+	for _, x := range v {
+		if math.IsNaN(x) {
+			return true
+		}
+	}
+	return false
+}
+
+// validate returns a *NonFiniteError for the first element of v that is
+// infinite or NaN, with symb the basis symbols, or nil if there is none.
+func validate(v []float64, symb []string) error {
+	for i, x := range v {
+		if math.IsInf(x, 0) || math.IsNaN(x) {
+			return &NonFiniteError{Index: i, Symbol: symb[i], Value: x}
+		}
+	}
+	return nil
+}
+
 const delta = 0.00000001
 
+// Tolerance is the absolute tolerance used by the Equals method of each type.
+// Two values are equal if each pair of matching components differs by at most
+// Tolerance. Use EqualsTol for a per-call tolerance, and Equal for an exact
+// comparison.
+var Tolerance = delta
+
+// equalsTol returns true if each element of v differs from the matching element
+// of w by at most tol.
+func equalsTol(v, w []float64, tol float64) bool {
+	for i := range v {
+		if ((v[i] - w[i]) > tol) || ((w[i] - v[i]) > tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalsULP returns true if each element of v is at most ulps units in the last
+// place away from the matching element of w. NaN elements are never equal.
+func equalsULP(v, w []float64, ulps uint) bool {
+	for i := range v {
+		if math.IsNaN(v[i]) || math.IsNaN(w[i]) {
+			return false
+		}
+		a, b := ordered(v[i]), ordered(w[i])
+		if a < b {
+			a, b = b, a
+		}
+		if uint64(a)-uint64(b) > uint64(ulps) {
+			return false
+		}
+	}
+	return true
+}
+
+// ordered maps the bits of a to an int64 value that increases with a, so that
+// adjacent float64 values map to adjacent integers and 0 and -0 both map to 0.
+func ordered(a float64) int64 {
+	i := int64(math.Float64bits(a))
+	if i < 0 {
+		i = math.MinInt64 - i
+	}
+	return i
+}
+
+// equalBits returns true if each element of v has the same bits as the
+// matching element of w.
+func equalBits(v, w []float64) bool {
+	for i := range v {
+		if math.Float64bits(v[i]) != math.Float64bits(w[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// compare returns -1, 0, or +1 as v is less than, equal to, or greater than w
+// in lexicographic order, comparing elements as cmp.Compare does.
+func compare(v, w []float64) int {
+	for i := range v {
+		if c := cmp.Compare(v[i], w[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// hash64 returns the 64-bit FNV-1a hash of the bits of the elements of v. If
+// step is positive, each element is first rounded to the nearest multiple of
+// step, and a zero result is hashed as 0 rather than -0.
+func hash64(v []float64, step float64) uint64 {
+	const (
+		offset = 14695981039346656037
+		prime  = 1099511628211
+	)
+	h := uint64(offset)
+	for _, a := range v {
+		if step > 0 {
+			a = math.Round(a/step) + 0
+		}
+		b := math.Float64bits(a)
+		for i := 0; i < 64; i += 8 {
+			h ^= (b >> i) & 0xff
+			h *= prime
+		}
+	}
+	return h
+}
+
+// condition returns the ratio of the Euclidean norm of v to that of its first
+// n elements, or +Inf if those elements are all zero.
+func condition(v []float64, n int) float64 {
+	r := hypot(v[:n])
+	if r == 0 {
+		return math.Inf(1)
+	}
+	return hypot(v) / r
+}
+
+// hypot returns the Euclidean norm of v, without undue overflow or underflow.
+func hypot(v []float64) float64 {
+	h := 0.0
+	for _, x := range v {
+		h = math.Hypot(h, x)
+	}
+	return h
+}
+
 // notEquals function returns true if a and b are not equal.
 func notEquals(a, b float64) bool {
 	return ((a - b) > delta) || ((b - a) > delta)
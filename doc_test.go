@@ -0,0 +1,557 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/meirizarrygelpi/quat"
+)
+
+func TestSetCartesian(t *testing.T) {
+	var z Hamilton
+	z.Set(1, 2, 3, 4, 5, 6, 7, 8)
+	if !z.Equals(NewHamilton(1, 2, 3, 4, 5, 6, 7, 8)) {
+		t.Errorf("Set = %v", &z)
+	}
+	if a, _, _, d, _, _, _, h := z.Cartesian(); a != 1 || d != 4 || h != 8 {
+		t.Errorf("Cartesian() = %v, %v, %v", a, d, h)
+	}
+	var p Perplex
+	p.Set(1, 2, 3, 4)
+	if a, b, c, d := p.Cartesian(); a != 1 || b != 2 || c != 3 || d != 4 {
+		t.Errorf("Perplex Cartesian() = %v, %v, %v, %v", a, b, c, d)
+	}
+	var u Ultra
+	u.Set(1, 2, 3, 4, 5, 6, 7, 8)
+	if !u.Equals(NewUltra(1, 2, 3, 4, 5, 6, 7, 8)) {
+		t.Errorf("Ultra Set = %v", &u)
+	}
+}
+
+func TestEqualsTol(t *testing.T) {
+	x, y := NewReal(1e10, 1), NewReal(1e10+1, 1)
+	if x.Equals(y) {
+		t.Errorf("%v.Equals(%v) = true", x, y)
+	}
+	if !x.EqualsTol(y, 2) {
+		t.Errorf("%v.EqualsTol(%v, 2) = false", x, y)
+	}
+	z, w := NewReal(0, 1), NewReal(math.Copysign(0, -1), 1)
+	if !z.Equals(w) || z.Equal(w) || !z.Equal(z) {
+		t.Errorf("Equal does not tell 0 from -0")
+	}
+	p, q := NewHamilton(1, 2, 3, 4, 5, 6, 7, 8), NewHamilton(1, 2, 3, 4, 5, 6, 7, 8.5)
+	if p.Equals(q) || !p.EqualsTol(q, 0.5) || !p.Equal(NewHamilton(1, 2, 3, 4, 5, 6, 7, 8)) {
+		t.Errorf("Hamilton EqualsTol and Equal")
+	}
+	defer func(tol float64) { Tolerance = tol }(Tolerance)
+	Tolerance = 1
+	if !p.Equals(q) {
+		t.Errorf("Equals does not use Tolerance")
+	}
+}
+
+func TestEqualsULP(t *testing.T) {
+	a := 1e300
+	b := math.Nextafter(math.Nextafter(a, math.Inf(1)), math.Inf(1))
+	x, y := NewReal(a, 1e-300), NewReal(b, 1e-300)
+	if !x.EqualsULP(y, 2) || x.EqualsULP(y, 1) {
+		t.Errorf("%v.EqualsULP(%v) wrong for 2 ulps apart", x, y)
+	}
+	z := NewReal(math.Copysign(0, -1), math.SmallestNonzeroFloat64)
+	if !z.EqualsULP(NewReal(0, -math.SmallestNonzeroFloat64), 2) {
+		t.Errorf("EqualsULP across 0 failed")
+	}
+	if RealNaN().EqualsULP(RealNaN(), 100) {
+		t.Errorf("NaN EqualsULP NaN")
+	}
+	p := NewUltra(1, 2, 3, 4, 5, 6, 7, 8)
+	if !p.EqualsULP(NewUltra(1, 2, 3, 4, 5, 6, 7, 8), 0) {
+		t.Errorf("Ultra EqualsULP failed")
+	}
+}
+
+func TestMulAliasing(t *testing.T) {
+	x, y := NewHamilton(1, 2, 3, 4, 5, 6, 7, 8), NewHamilton(8, 7, 6, 5, 4, 3, 2, 1)
+	want := new(Hamilton).Mul(x, y)
+	if got := new(Hamilton).Copy(x).Mul(new(Hamilton).Copy(x), y); !got.Equals(want) {
+		t.Errorf("Mul = %v, want %v", got, want)
+	}
+	if z := new(Hamilton).Copy(x); !z.Mul(z, y).Equals(want) {
+		t.Errorf("z.Mul(z, y) = %v, want %v", z, want)
+	}
+	if z := new(Hamilton).Copy(y); !z.Mul(x, z).Equals(want) {
+		t.Errorf("z.Mul(x, z) = %v, want %v", z, want)
+	}
+	if !y.Equals(NewHamilton(8, 7, 6, 5, 4, 3, 2, 1)) {
+		t.Errorf("Mul modified y: %v", y)
+	}
+	p, q := NewPerplex(1, 2, 3, 4), NewPerplex(5, 6, 7, 8)
+	pq := new(Perplex).Mul(p, q)
+	if z := new(Perplex).Copy(p); !z.Mul(z, q).Equals(pq) {
+		t.Errorf("z.Mul(z, q) = %v, want %v", z, pq)
+	}
+	if z := new(Perplex).Copy(q); !z.Mul(p, z).Equals(pq) {
+		t.Errorf("z.Mul(p, z) = %v, want %v", z, pq)
+	}
+	r := NewComplex(1, 2, 3, 4)
+	rr := new(Complex).Mul(r, r)
+	if !r.Mul(r, r).Equals(rr) {
+		t.Errorf("z.Mul(z, z) = %v, want %v", r, rr)
+	}
+	a, b := NewReal(1, 2), NewReal(3, 4)
+	if n := testing.AllocsPerRun(100, func() { a.Mul(a, b) }); n != 0 {
+		t.Errorf("Real Mul allocates %v times", n)
+	}
+}
+
+func TestMulTemporaries(t *testing.T) {
+	x, y := NewHamilton(1, 2, 3, 4, 5, 6, 7, 8), NewHamilton(8, 7, 6, 5, 4, 3, 2, 1)
+	z := new(Hamilton)
+	if n := testing.AllocsPerRun(100, func() { z.Mul(x, y) }); n != 0 {
+		t.Errorf("Hamilton Mul allocates %v times", n)
+	}
+	p, q := NewPerplex(1, 2, 3, 4), NewPerplex(5, 6, 7, 8)
+	w := NewPerplex(0, 0, 0, 0)
+	if n := testing.AllocsPerRun(100, func() { w.Mul(p, q) }); n != 0 {
+		t.Errorf("Perplex Mul allocates %v times", n)
+	}
+	if n := testing.AllocsPerRun(100, func() { p.Mul(p, q) }); n != 0 {
+		t.Errorf("Perplex Mul in place allocates %v times", n)
+	}
+	p = NewPerplex(1, 2, 3, 4)
+	if got, want := new(Perplex).Mul(p, q), NewPerplex(0, 0, 0, 0).Mul(p, q); !got.Equals(want) {
+		t.Errorf("Mul into a zero Perplex = %v, want %v", got, want)
+	}
+	want := NewPerplex(0, 0, 0, 0).Sub(new(Perplex).Mul(p, q), new(Perplex).Mul(q, p))
+	if got := new(Perplex).Commutator(p, q); !got.Equals(want) {
+		t.Errorf("Commutator = %v, want %v", got, want)
+	}
+}
+
+func TestMulFMA(t *testing.T) {
+	// The dual part (1+2⁻²⁷)(1-2⁻²⁷) - 1 = -2⁻⁵⁴ is lost to rounding when the
+	// product is rounded before the sum.
+	e := math.Ldexp(1, -27)
+	x, y := NewReal(1+e, -1), NewReal(1, 1-e)
+	if got, want := new(Real).Mul(x, y).Dual(), -math.Ldexp(1, -54); got != want {
+		t.Errorf("Real Mul dual part = %g, want %g", got, want)
+	}
+	if naive := (x.Real() * y.Dual()) + (x.Dual() * y.Real()); naive != 0 {
+		t.Errorf("naive dual part = %g, want 0", naive)
+	}
+	p, q := NewComplex(1+e, 1, 0, 0), NewComplex(1-e, 1, 0, 0)
+	if got, want := real(new(Complex).Mul(p, q)[0]), -math.Ldexp(1, -54); got != want {
+		t.Errorf("Complex Mul real part = %g, want %g", got, want)
+	}
+}
+
+func TestMulAdd(t *testing.T) {
+	r := []*Real{NewReal(1, 2), NewReal(3, 4), NewReal(5, 6)}
+	if got, want := new(Real).MulAdd(r[0], r[1], r[2]), new(Real).Add(new(Real).Mul(r[0], r[1]), r[2]); !got.Equals(want) {
+		t.Errorf("Real MulAdd = %v, want %v", got, want)
+	}
+	c := []*Complex{NewComplex(1, 2, 3, 4), NewComplex(5, 6, 7, 8), NewComplex(9, 1, 2, 3)}
+	if got, want := new(Complex).MulAdd(c[0], c[1], c[2]), new(Complex).Add(new(Complex).Mul(c[0], c[1]), c[2]); !got.Equals(want) {
+		t.Errorf("Complex MulAdd = %v, want %v", got, want)
+	}
+	h := []*Hamilton{NewHamilton(1, 2, 3, 4, 5, 6, 7, 8), NewHamilton(8, 7, 6, 5, 4, 3, 2, 1), NewHamilton(1, 1, 2, 3, 5, 8, 13, 21)}
+	if got, want := new(Hamilton).MulAdd(h[0], h[1], h[2]), new(Hamilton).Add(new(Hamilton).Mul(h[0], h[1]), h[2]); !got.Equals(want) {
+		t.Errorf("Hamilton MulAdd = %v, want %v", got, want)
+	}
+	p := []*Perplex{NewPerplex(1, 2, 3, 4), NewPerplex(5, 6, 7, 8), NewPerplex(9, 1, 2, 3)}
+	if got, want := NewPerplex(0, 0, 0, 0).MulAdd(p[0], p[1], p[2]), NewPerplex(0, 0, 0, 0).Add(new(Perplex).Mul(p[0], p[1]), p[2]); !got.Equals(want) {
+		t.Errorf("Perplex MulAdd = %v, want %v", got, want)
+	}
+	s := []*Super{NewSuper(1, 2, 3, 4), NewSuper(5, 6, 7, 8), NewSuper(9, 1, 2, 3)}
+	if got, want := new(Super).MulAdd(s[0], s[1], s[2]), new(Super).Add(new(Super).Mul(s[0], s[1]), s[2]); !got.Equals(want) {
+		t.Errorf("Super MulAdd = %v, want %v", got, want)
+	}
+	y := []*Hyper{NewHyper(1, 2, 3, 4), NewHyper(5, 6, 7, 8), NewHyper(9, 1, 2, 3)}
+	if got, want := new(Hyper).MulAdd(y[0], y[1], y[2]), new(Hyper).Add(new(Hyper).Mul(y[0], y[1]), y[2]); !got.Equals(want) {
+		t.Errorf("Hyper MulAdd = %v, want %v", got, want)
+	}
+	u := []*Ultra{
+		NewUltra(1, 2, 3, 4, 5, 6, 7, 8),
+		NewUltra(8, 7, 6, 5, 4, 3, 2, 1),
+		NewUltra(1, 1, 2, 3, 5, 8, 13, 21),
+	}
+	want := new(Ultra).Add(new(Ultra).Mul(u[0], u[1]), u[2])
+	if got := new(Ultra).MulAdd(u[0], u[1], u[2]); !got.Equals(want) {
+		t.Errorf("Ultra MulAdd = %v, want %v", got, want)
+	}
+	if z := new(Ultra).Copy(u[2]); !z.MulAdd(u[0], u[1], z).Equals(want) {
+		t.Errorf("Ultra MulAdd with z = w = %v, want %v", z, want)
+	}
+	if n := testing.AllocsPerRun(100, func() { u[0].MulAdd(u[0], u[1], u[2]) }); n != 0 {
+		t.Errorf("Ultra MulAdd allocates %v times", n)
+	}
+	// 3·(1/3) - 1 is not zero once 1/3 is rounded, and only the fused form
+	// keeps the difference.
+	third := 1.0 / 3
+	if got := new(Real).MulAdd(NewReal(3, 0), NewReal(third, 0), NewReal(-1, 0)).Real(); got != math.FMA(3, third, -1) || got == 0 {
+		t.Errorf("Real MulAdd real part = %g", got)
+	}
+	fused := math.FMA(3, third, -1)
+	q := new(Hamilton).MulAdd(NewHamilton(3, 0, 0, 0, 0, 0, 0, 0), NewHamilton(third, 0, 0, 0, third, 0, 0, 0), NewHamilton(-1, 0, 0, 0, -1, 0, 0, 0))
+	if a, e := q.ScalarPart(); a != fused || e != fused {
+		t.Errorf("Hamilton MulAdd scalar parts = %g, %g, want %g", a, e, fused)
+	}
+	v := NewPerplex(0, 0, 0, 0).MulAdd(NewPerplex(3, 0, 0, 0), NewPerplex(third, 0, third, 0), NewPerplex(-1, 0, -1, 0))
+	if a, _, e, _ := v.Cartesian(); a != fused || e != fused {
+		t.Errorf("Perplex MulAdd = %v, want real parts %g", v, fused)
+	}
+	if n := testing.AllocsPerRun(100, func() { v.MulAdd(p[0], p[1], p[2]) }); n != 0 {
+		t.Errorf("Perplex MulAdd allocates %v times", n)
+	}
+	// A dual part that Mul leaves out, with an infinite factor, must not turn
+	// the fused sum into NaN.
+	inf := math.Inf(1)
+	a, b := NewHamilton(inf, 0, 0, 0, 1, 0, 0, 0), NewHamilton(1, 0, 0, 0, 0, 0, 0, 0)
+	w := NewHamilton(0, 0, 0, 0, 2, 0, 0, 0)
+	var m quat.Hamilton
+	m.Add(new(Hamilton).Mul(a, b).Dual(), w.Dual())
+	if got := new(Hamilton).MulAdd(a, b, w); got[1] != m {
+		t.Errorf("Hamilton MulAdd(%v, %v, %v) = %v", a, b, w, got)
+	}
+}
+
+func TestInfNaN(t *testing.T) {
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		name      string
+		got, want *Real
+	}{
+		{"Inf·2", new(Real).Mul(NewReal(inf, 0), NewReal(2, 0)), NewReal(inf, 0)},
+		{"2·Inf", new(Real).Mul(NewReal(2, 0), NewReal(inf, 0)), NewReal(inf, 0)},
+		{"Inf·(1+ε)", new(Real).Mul(NewReal(inf, 0), NewReal(1, 1)), NewReal(inf, inf)},
+		{"Inf·(1-ε)", new(Real).Mul(NewReal(inf, 0), NewReal(1, -1)), NewReal(inf, -inf)},
+		{"(1+Infε)·2", new(Real).Mul(NewReal(1, inf), NewReal(2, 0)), NewReal(2, inf)},
+		{"Inf/2", new(Real).Quo(NewReal(inf, 0), NewReal(2, 0)), NewReal(inf, 0)},
+		{"1/Inf", new(Real).Quo(NewReal(1, 0), NewReal(inf, 0)), NewReal(0, 0)},
+		{"(1+ε)/Inf", new(Real).Quo(NewReal(1, 1), NewReal(inf, 1)), NewReal(0, 0)},
+		{"Inv(Inf)", new(Real).Inv(NewReal(inf, 1)), NewReal(0, 0)},
+		{"Inf+(-Inf)", new(Real).Add(NewReal(inf, 1), NewReal(-inf, 1)), NewReal(math.NaN(), 2)},
+	} {
+		if !test.got.EqualsULP(test.want, 0) && !(test.got.IsNaN() && test.want.IsNaN() && test.got.Dual() == test.want.Dual()) {
+			t.Errorf("%s = %v, want %v", test.name, test.got, test.want)
+		}
+	}
+	if got := new(Real).Mul(NewReal(inf, 0), NewReal(0, 1)); !math.IsNaN(got.Real()) || got.Dual() != inf {
+		t.Errorf("Inf·ε = %v, want (NaN+Infε)", got)
+	}
+	h := new(Hyper).Mul(NewHyper(inf, 0, 0, 0), NewHyper(2, 0, 0, 0))
+	if !h.Equal(NewHyper(inf, 0, 0, 0)) {
+		t.Errorf("Hyper Inf·2 = %v", h)
+	}
+	s := new(Super).MulAdd(NewSuper(inf, 0, 0, 0), NewSuper(2, 0, 0, 0), NewSuper(0, 0, 1, 0))
+	if !s.Equal(NewSuper(inf, 0, 1, 0)) {
+		t.Errorf("Super Inf·2 + τ = %v", s)
+	}
+	q := new(Hamilton).Mul(NewHamilton(inf, 0, 0, 0, 0, 0, 0, 0), NewHamilton(0, 0, 0, 0, 1, 0, 0, 0))
+	if w := q.Dual(); real(w[0]) != inf {
+		t.Errorf("Hamilton Inf·ε = %v", q)
+	}
+	c := new(Complex).Mul(NewComplex(inf, 0, 0, 0), NewComplex(2, 0, 0, 0))
+	if v := c.components(); !math.IsInf(v[0], 1) || v[2] != 0 || v[3] != 0 {
+		t.Errorf("Complex Inf·2 = %v", c)
+	}
+}
+
+func TestNaNTimesZero(t *testing.T) {
+	// A term with a zero factor is left out of a product only when the other
+	// factor is infinite. A NaN factor makes the term NaN.
+	nan, inf := math.NaN(), math.Inf(1)
+	if got := new(Real).Mul(NewReal(1, nan), NewReal(0, 0)); got.Real() != 0 || !math.IsNaN(got.Dual()) {
+		t.Errorf("(1+NaNε)·0 = %v, want (0+NaNε)", got)
+	}
+	if got := new(Real).MulAdd(NewReal(1, nan), NewReal(0, 0), NewReal(1, 1)); !math.IsNaN(got.Dual()) {
+		t.Errorf("(1+NaNε)·0 + (1+ε) = %v, want a NaN dual part", got)
+	}
+	if got := new(Real).Mul(NewReal(1, inf), NewReal(0, 0)); !got.Equal(NewReal(0, 0)) {
+		t.Errorf("(1+Infε)·0 = %v, want (0+0ε)", got)
+	}
+	if got := new(Real).Mul(NewReal(0, 0), NewReal(inf, 1)); !math.IsNaN(got.Real()) || got.Dual() != 0 {
+		t.Errorf("0·(Inf+ε) = %v, want (NaN+0ε)", got)
+	}
+	c := new(Complex).Mul(NewComplex(1, 0, nan, 0), NewComplex(0, 0, 0, 0))
+	if v := c.components(); v[0] != 0 || !math.IsNaN(v[2]) {
+		t.Errorf("Complex (1+NaNε)·0 = %v, want a NaN dual part", c)
+	}
+	c = new(Complex).Mul(NewComplex(1, 0, inf, 0), NewComplex(0, 0, 0, 0))
+	if v := c.components(); !isFinite(v) {
+		t.Errorf("Complex (1+Infε)·0 = %v, want 0", c)
+	}
+	c = new(Complex).Mul(NewComplex(1, 0, inf, nan), NewComplex(0, 0, 0, 0))
+	if v := c.components(); !hasNaN(v[2:]...) {
+		t.Errorf("Complex (1+(Inf+NaNi)ε)·0 = %v, want a NaN dual part", c)
+	}
+	h := new(Hamilton).Mul(NewHamilton(nan, 0, 0, 0, 0, 0, 0, 0), NewHamilton(2, 0, 0, 0, 0, 0, 0, 0))
+	if v := h.components(); !hasNaN(v[4:]...) {
+		t.Errorf("Hamilton NaN·2 = %v, want a NaN dual part", h)
+	}
+	h = new(Hamilton).Mul(NewHamilton(2, 0, 0, 0, 0, 0, 0, 0), NewHamilton(nan, 0, 0, 0, 0, 0, 0, 0))
+	if v := h.components(); !hasNaN(v[4:]...) {
+		t.Errorf("Hamilton 2·NaN = %v, want a NaN dual part", h)
+	}
+	h = new(Hamilton).Mul(NewHamilton(inf, 0, 0, 0, 0, 0, 0, 0), NewHamilton(2, 0, 0, 0, 0, 0, 0, 0))
+	if v := h.components(); hasNaN(v[4:]...) || v[4] != 0 {
+		t.Errorf("Hamilton Inf·2 = %v, want a zero dual part", h)
+	}
+	s := new(Super).Mul(NewSuper(nan, 0, 0, 0), NewSuper(2, 0, 0, 0))
+	if !hasNaN(s[2:]...) {
+		t.Errorf("Super NaN·2 = %v, want a NaN dual part", s)
+	}
+	s = new(Super).MulAdd(NewSuper(2, 0, 0, 0), NewSuper(nan, 0, 0, 0), NewSuper(0, 0, 0, 0))
+	if !hasNaN(s[2:]...) {
+		t.Errorf("Super 2·NaN + 0 = %v, want a NaN dual part", s)
+	}
+	y := new(Hyper).Mul(NewHyper(nan, 0, 0, 0), NewHyper(2, 0, 0, 0))
+	if !hasNaN(y[2:]...) {
+		t.Errorf("Hyper NaN·2 = %v, want a NaN dual part", y)
+	}
+	y = new(Hyper).MulAdd(NewHyper(2, 0, 0, 0), NewHyper(nan, 0, 0, 0), NewHyper(0, 0, 0, 0))
+	if !hasNaN(y[2:]...) {
+		t.Errorf("Hyper 2·NaN + 0 = %v, want a NaN dual part", y)
+	}
+	u := new(Ultra).Mul(NewUltra(nan, 0, 0, 0, 0, 0, 0, 0), NewUltra(2, 0, 0, 0, 0, 0, 0, 0))
+	if !hasNaN(u[4:]...) {
+		t.Errorf("Ultra NaN·2 = %v, want a NaN dual part", u)
+	}
+	u = new(Ultra).Mul(NewUltra(inf, 0, 0, 0, 0, 0, 0, 0), NewUltra(2, 0, 0, 0, 0, 0, 0, 0))
+	if !u.Equal(NewUltra(inf, 0, 0, 0, 0, 0, 0, 0)) {
+		t.Errorf("Ultra Inf·2 = %v", u)
+	}
+}
+
+func TestScaledDivision(t *testing.T) {
+	// Tiny values are zero divisors under the absolute tolerance, so only
+	// large ones are checked; their quadrance overflows.
+	for _, s := range []float64{1, 1e200, 1e300} {
+		x := NewReal(3*s, 4*s)
+		if got, want := new(Real).Quo(x, x), NewReal(1, 0); !got.Equals(want) {
+			t.Errorf("Real Quo(%v, %v) = %v, want %v", x, x, got, want)
+		}
+		if got := new(Real).Mul(x, new(Real).Inv(x)); !got.Equals(NewReal(1, 0)) {
+			t.Errorf("Real %v times its inverse = %v", x, got)
+		}
+		h := NewHyper(3*s, 1*s, 4*s, 2*s)
+		if got := new(Hyper).Mul(h, new(Hyper).Inv(h)); !got.Equals(NewHyper(1, 0, 0, 0)) {
+			t.Errorf("Hyper %v times its inverse = %v", h, got)
+		}
+		c := NewComplex(3*s, 4*s, 1*s, -2*s)
+		one := NewComplex(1, 0, 0, 0)
+		if got := new(Complex).Quo(c, c); !got.Equals(one) {
+			t.Errorf("Complex Quo(%v, %v) = %v", c, c, got)
+		}
+		if got := new(Complex).Mul(new(Complex).Inv(c), c); !got.Equals(one) {
+			t.Errorf("Complex inverse of %v times it = %v", c, got)
+		}
+		q := NewHamilton(1*s, 2*s, 3*s, 4*s, 5*s, 6*s, 7*s, 8*s)
+		u := new(Hamilton).Inv(q)
+		id := NewHamilton(1, 0, 0, 0, 0, 0, 0, 0)
+		if got := new(Hamilton).Mul(q, u); !got.Equals(id) {
+			t.Errorf("Hamilton %v times its inverse = %v", q, got)
+		}
+		if got := new(Hamilton).Mul(u, q); !got.Equals(id) {
+			t.Errorf("Hamilton inverse of %v times it = %v", q, got)
+		}
+		if got := new(Hamilton).Quo(q, q); !got.Equals(id) {
+			t.Errorf("Hamilton Quo(%v, %v) = %v", q, q, got)
+		}
+	}
+	if got, want := new(Complex).Inv(NewComplex(0, 2, 1, 0)), NewComplex(0, -0.5, -0.25, 0); !got.Equals(want) {
+		t.Errorf("Complex Inv = %v, want %v", got, want)
+	}
+	if _, err := new(Hamilton).InvChecked(NewHamilton(0, 0, 0, 0, 1, 0, 0, 0)); err != ErrZeroDivisor {
+		t.Errorf("Hamilton InvChecked of a zero divisor: err = %v", err)
+	}
+	if _, err := new(Complex).QuoChecked(NewComplex(1, 0, 0, 0), NewComplex(0, 0, 1, 0)); err != ErrZeroDivisor {
+		t.Errorf("Complex QuoChecked by a zero divisor: err = %v", err)
+	}
+}
+
+func TestCond(t *testing.T) {
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		name      string
+		got, want float64
+	}{
+		{"Real 1", NewReal(1, 0).Cond(), 1},
+		{"Real 3+4ε", NewReal(3, 4).Cond(), 5.0 / 3},
+		{"Real ε", NewReal(0, 1).Cond(), inf},
+		{"Real 1e-10+ε", NewReal(1e-10, 1).Cond(), 1e10},
+		{"Real 1e300+1e300ε", NewReal(1e300, 1e300).Cond(), math.Sqrt2},
+		{"Complex", NewComplex(3, 4, 0, 5).Cond(), math.Sqrt2},
+		{"Complex εi", NewComplex(0, 0, 0, 1).Cond(), inf},
+		{"Hamilton", NewHamilton(1, 1, 1, 1, 2, 2, 2, 2).Cond(), math.Sqrt(5)},
+		{"Hamilton εj", NewHamilton(0, 0, 0, 0, 0, 0, 1, 0).Cond(), inf},
+		{"Hyper", NewHyper(2, 0, 0, 0).Cond(), 1},
+		{"Hyper ε", NewHyper(0, 1, 0, 0).Cond(), inf},
+	} {
+		if test.got != test.want && notEquals(test.got, test.want) {
+			t.Errorf("%s: Cond = %v, want %v", test.name, test.got, test.want)
+		}
+	}
+}
+
+func TestDivisionNaN(t *testing.T) {
+	if got := new(Real).InvNaN(NewReal(0, 1)); !got.IsNaN() {
+		t.Errorf("Real InvNaN(ε) = %v, want NaN", got)
+	}
+	if got, want := new(Real).QuoNaN(NewReal(1, 0), NewReal(2, 0)), NewReal(0.5, 0); !got.Equals(want) {
+		t.Errorf("Real QuoNaN = %v, want %v", got, want)
+	}
+	if got := new(Complex).QuoNaN(NewComplex(1, 0, 0, 0), NewComplex(0, 0, 1, 0)); !got.IsNaN() {
+		t.Errorf("Complex QuoNaN by ε = %v, want NaN", got)
+	}
+	if got := new(Hamilton).InvNaN(NewHamilton(0, 0, 0, 0, 0, 1, 0, 0)); !got.IsNaN() {
+		t.Errorf("Hamilton InvNaN(εi) = %v, want NaN", got)
+	}
+	if got := new(Hyper).QuoNaN(NewHyper(1, 0, 0, 0), NewHyper(0, 0, 1, 0)); !got.IsNaN() {
+		t.Errorf("Hyper QuoNaN by η = %v, want NaN", got)
+	}
+	if got, want := new(Hyper).InvNaN(NewHyper(2, 0, 0, 0)), NewHyper(0.5, 0, 0, 0); !got.Equals(want) {
+		t.Errorf("Hyper InvNaN = %v, want %v", got, want)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+	for _, z := range []interface {
+		IsFinite() bool
+		Validate() error
+	}{
+		NewReal(1, 2), NewComplex(1, 2, 3, 4), NewHamilton(1, 2, 3, 4, 5, 6, 7, 8),
+		NewPerplex(1, 2, 3, 4), NewSuper(1, 2, 3, 4), NewHyper(1, 2, 3, 4),
+		NewUltra(1, 2, 3, 4, 5, 6, 7, 8),
+	} {
+		if !z.IsFinite() || z.Validate() != nil {
+			t.Errorf("%v: IsFinite = %v, Validate = %v", z, z.IsFinite(), z.Validate())
+		}
+	}
+	for _, test := range []struct {
+		z interface {
+			IsFinite() bool
+			Validate() error
+		}
+		want string
+	}{
+		{NewReal(inf, nan), "dual: component 0 is +Inf"},
+		{NewComplex(1, 2, nan, 4), "dual: component 2 (ε) is NaN"},
+		{NewHamilton(1, 2, 3, 4, 5, 6, -inf, 8), "dual: component 6 (εj) is -Inf"},
+		{NewPerplex(1, nan, 3, 4), "dual: component 1 (s) is NaN"},
+		{NewSuper(1, 2, 3, inf), "dual: component 3 (στ) is +Inf"},
+		{NewHyper(1, 2, nan, 4), "dual: component 2 (η) is NaN"},
+		{NewUltra(1, 2, 3, 4, 5, 6, 7, nan), "dual: component 7 (υ₇) is NaN"},
+	} {
+		err := test.z.Validate()
+		if test.z.IsFinite() || err == nil || err.Error() != test.want {
+			t.Errorf("Validate = %v, want %q", err, test.want)
+		}
+		if e, ok := err.(*NonFiniteError); !ok || e.Index < 0 {
+			t.Errorf("Validate returned %T", err)
+		}
+	}
+}
+
+func TestHash64(t *testing.T) {
+	x, y := NewReal(1, 2), NewReal(1, 2)
+	if x.Hash64(0) != y.Hash64(0) {
+		t.Errorf("equal values hash differently")
+	}
+	if x.Hash64(0) == NewReal(2, 1).Hash64(0) {
+		t.Errorf("swapped components hash the same")
+	}
+	if NewReal(0, 1).Hash64(0) == NewReal(math.Copysign(0, -1), 1).Hash64(0) {
+		t.Errorf("0 and -0 hash the same without a step")
+	}
+	if NewReal(0, 1).Hash64(0.5) != NewReal(-0.1, 1.1).Hash64(0.5) {
+		t.Errorf("values in the same step hash differently")
+	}
+	if NewReal(1e-16, 0).Hash64(0) == NewReal(0, 0).Hash64(0) {
+		t.Errorf("values that Equals reports equal hash the same without a step")
+	}
+	seen := make(map[uint64]bool)
+	for _, h := range []uint64{
+		NewComplex(1, 2, 3, 4).Hash64(0),
+		NewPerplex(1, 2, 3, 4).Hash64(0.5),
+		NewHamilton(1, 2, 3, 4, 5, 6, 7, 8).Hash64(0),
+		(&Super{1, 2, 3, 5}).Hash64(0),
+		(&Hyper{1, 2, 3, 6}).Hash64(0),
+		(&Ultra{1, 2, 3, 4, 5, 6, 7, 9}).Hash64(0),
+		NewLaguerre(NewReal(1, 2), NewReal(3, 4), NewReal(5, 6), NewReal(7, 10)).Hash64(0),
+	} {
+		if seen[h] {
+			t.Errorf("hash collision on %x", h)
+		}
+		seen[h] = true
+	}
+	if NewHamilton(1, 2, 3, 4, 5, 6, 7, 8).Hash64(0) != NewHamilton(1, 2, 3, 4, 5, 6, 7, 8).Hash64(0) {
+		t.Errorf("Hamilton hash is not deterministic")
+	}
+}
+
+func TestCmp(t *testing.T) {
+	nan := math.NaN()
+	tests := []struct {
+		x, y *Real
+		want int
+	}{
+		{NewReal(1, 5), NewReal(2, 0), -1},
+		{NewReal(2, 0), NewReal(1, 5), +1},
+		{NewReal(1, 2), NewReal(1, 3), -1},
+		{NewReal(1, 2), NewReal(1, 2), 0},
+		{NewReal(0, 1), NewReal(math.Copysign(0, -1), 1), 0},
+		{NewReal(nan, 1), NewReal(math.Inf(-1), 0), -1},
+		{NewReal(nan, 1), NewReal(nan, 1), 0},
+	}
+	for _, test := range tests {
+		if got := test.x.Cmp(test.y); got != test.want {
+			t.Errorf("Cmp(%v, %v) = %d, want %d", test.x, test.y, got, test.want)
+		}
+	}
+	if got := NewHamilton(1, 2, 3, 4, 5, 6, 7, 8).Cmp(NewHamilton(1, 2, 3, 4, 5, 6, 7, 9)); got != -1 {
+		t.Errorf("Hamilton Cmp = %d, want -1", got)
+	}
+	if got := NewComplex(1, 3, 0, 0).Cmp(NewComplex(1, 2, 9, 9)); got != +1 {
+		t.Errorf("Complex Cmp = %d, want +1", got)
+	}
+	if got := NewPerplex(1, 2, 3, 4).Cmp(NewPerplex(1, 2, 3, 4)); got != 0 {
+		t.Errorf("Perplex Cmp = %d, want 0", got)
+	}
+	if (&Super{0, 1}).Cmp(&Super{0, 2}) != -1 || (&Hyper{1}).Cmp(&Hyper{0, 9}) != +1 || (&Ultra{}).Cmp(&Ultra{}) != 0 {
+		t.Errorf("componentwise Cmp is not lexicographic")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	x := NewHamilton(1, 2, 3, 4, 5, 6, 7, 8)
+	if d := x.Diff(NewHamilton(1, 2, 3, 4, 5, 6, 7, 8)); len(d) != 0 || d.String() != "no difference" {
+		t.Errorf("Diff of equal values = %v", d)
+	}
+	y := NewHamilton(1, 2, 3, 4, 5, math.Nextafter(6, 7), 7, 8.5)
+	d := x.Diff(y)
+	if len(d) != 2 {
+		t.Fatalf("Diff = %v, want 2 components", d)
+	}
+	if c := d[0]; c.Index != 5 || c.Symbol != "εi" || c.ULPs != 1 || c.Got != 6 {
+		t.Errorf("Diff[0] = %+v", c)
+	}
+	if c := d[1]; c.Index != 7 || c.Abs != 0.5 || c.Want != 8.5 {
+		t.Errorf("Diff[1] = %+v", c)
+	}
+	if s := d.String(); !strings.Contains(s, "component 7 (εk): got 8, want 8.5, off by 0.5") {
+		t.Errorf("Diff.String() = %q", s)
+	}
+	if c := NewReal(math.NaN(), 0).Diff(NewReal(1, 0))[0]; !math.IsNaN(c.Abs) || c.ULPs != math.MaxUint64 {
+		t.Errorf("Diff with NaN = %+v", c)
+	}
+	if d := NewReal(0, 1).Diff(NewReal(math.Copysign(0, -1), 1)); len(d) != 1 || d[0].ULPs != 0 {
+		t.Errorf("Diff of 0 and -0 = %+v", d)
+	}
+}
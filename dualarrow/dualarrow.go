@@ -0,0 +1,112 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+// Package dualarrow converts between slices of the values of package dual and
+// Apache Arrow arrays, for writing them to Parquet or Feather files and
+// sharing them with other languages without custom marshalling.
+//
+// A dual.Real is a fixed-size list of two float64 values, the real part and
+// the dual part, with the type RealType. A dual.Hamilton is a fixed-size list
+// of eight float64 values, in the order of its Cartesian method, with the
+// type HamiltonType. The first four are the real quaternion part, and the
+// last four are the dual quaternion part.
+package dualarrow
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/meirizarrygelpi/dual"
+)
+
+var (
+	// RealType is the Arrow type of a dual.Real value.
+	RealType = arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Float64)
+
+	// HamiltonType is the Arrow type of a dual.Hamilton value.
+	HamiltonType = arrow.FixedSizeListOf(8, arrow.PrimitiveTypes.Float64)
+)
+
+// newList returns a fixed-size list array of n float64 values per element,
+// appending the components of each element with the function f.
+func newList(mem memory.Allocator, n int32, m int, f func(i int, b *array.Float64Builder)) *array.FixedSizeList {
+	b := array.NewFixedSizeListBuilder(mem, n, arrow.PrimitiveTypes.Float64)
+	defer b.Release()
+	b.Reserve(m)
+	vb := b.ValueBuilder().(*array.Float64Builder)
+	vb.Reserve(m * int(n))
+	for i := 0; i < m; i++ {
+		b.Append(true)
+		f(i, vb)
+	}
+	return b.NewListArray()
+}
+
+// values returns the float64 values of a, checking that each element has n
+// non-null values and that a has no null elements.
+func values(a *array.FixedSizeList, n int32) ([]float64, error) {
+	t, ok := a.DataType().(*arrow.FixedSizeListType)
+	if !ok || t.Len() != n || t.Elem().ID() != arrow.FLOAT64 {
+		return nil, fmt.Errorf("dualarrow: type %s, want %s", a.DataType(), arrow.FixedSizeListOf(n, arrow.PrimitiveTypes.Float64))
+	}
+	if a.NullN() > 0 {
+		return nil, fmt.Errorf("dualarrow: %d null elements", a.NullN())
+	}
+	if a.Len() == 0 {
+		return nil, nil
+	}
+	v := a.ListValues().(*array.Float64)
+	start, _ := a.ValueOffsets(0)
+	if v.NullN() > 0 {
+		return nil, fmt.Errorf("dualarrow: %d null values", v.NullN())
+	}
+	return v.Float64Values()[start : start+int64(a.Len())*int64(n)], nil
+}
+
+// RealArray returns the Arrow array of RealType with the values x. The caller
+// must release it.
+func RealArray(mem memory.Allocator, x []dual.Real) *array.FixedSizeList {
+	return newList(mem, 2, len(x), func(i int, b *array.Float64Builder) {
+		b.AppendValues(x[i][:], nil)
+	})
+}
+
+// Reals returns the values of the Arrow array a of RealType. It returns an
+// error if a has a different type or any null values.
+func Reals(a *array.FixedSizeList) ([]dual.Real, error) {
+	v, err := values(a, 2)
+	if err != nil {
+		return nil, err
+	}
+	x := make([]dual.Real, a.Len())
+	for i := range x {
+		x[i] = dual.Real{v[2*i], v[2*i+1]}
+	}
+	return x, nil
+}
+
+// HamiltonArray returns the Arrow array of HamiltonType with the values x.
+// The caller must release it.
+func HamiltonArray(mem memory.Allocator, x []dual.Hamilton) *array.FixedSizeList {
+	return newList(mem, 8, len(x), func(i int, b *array.Float64Builder) {
+		a, c, d, e, f, g, h, k := x[i].Cartesian()
+		b.AppendValues([]float64{a, c, d, e, f, g, h, k}, nil)
+	})
+}
+
+// Hamiltons returns the values of the Arrow array a of HamiltonType. It
+// returns an error if a has a different type or any null values.
+func Hamiltons(a *array.FixedSizeList) ([]dual.Hamilton, error) {
+	v, err := values(a, 8)
+	if err != nil {
+		return nil, err
+	}
+	x := make([]dual.Hamilton, a.Len())
+	for i := range x {
+		w := v[8*i : 8*i+8]
+		x[i] = *dual.NewHamilton(w[0], w[1], w[2], w[3], w[4], w[5], w[6], w[7])
+	}
+	return x, nil
+}
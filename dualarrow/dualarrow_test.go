@@ -0,0 +1,80 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dualarrow
+
+import (
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/meirizarrygelpi/dual"
+)
+
+func TestReals(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+	x := []dual.Real{{1, 2}, {-3, 4}, {5, -6}}
+	a := RealArray(mem, x)
+	defer a.Release()
+	if !arrow.TypeEqual(a.DataType(), RealType) {
+		t.Errorf("type = %s, want %s", a.DataType(), RealType)
+	}
+	y, err := Reals(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(y) != len(x) {
+		t.Fatalf("len = %d, want %d", len(y), len(x))
+	}
+	for i := range x {
+		if y[i] != x[i] {
+			t.Errorf("Reals()[%d] = %v, want %v", i, y[i], x[i])
+		}
+	}
+	// A slice of the array starts at an offset into the values.
+	s := array.NewSlice(a, 1, 3).(*array.FixedSizeList)
+	defer s.Release()
+	if y, err := Reals(s); err != nil || len(y) != 2 || y[0] != x[1] || y[1] != x[2] {
+		t.Errorf("Reals(slice) = %v, %v, want %v", y, err, x[1:])
+	}
+}
+
+func TestHamiltons(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+	x := []dual.Hamilton{
+		*dual.NewHamilton(1, 2, 3, 4, 5, 6, 7, 8),
+		*dual.NewHamilton(-1, 0, 0.5, 0, 0, 2, 0, -3),
+	}
+	a := HamiltonArray(mem, x)
+	defer a.Release()
+	y, err := Hamiltons(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range x {
+		if !y[i].Equals(&x[i]) {
+			t.Errorf("Hamiltons()[%d] = %v, want %v", i, &y[i], &x[i])
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+	a := RealArray(mem, []dual.Real{{1, 2}})
+	defer a.Release()
+	if _, err := Hamiltons(a); err == nil {
+		t.Error("Hamiltons of a RealType array: no error")
+	}
+	b := array.NewFixedSizeListBuilder(mem, 2, arrow.PrimitiveTypes.Float64)
+	defer b.Release()
+	b.AppendNull()
+	n := b.NewListArray()
+	defer n.Release()
+	if _, err := Reals(n); err == nil {
+		t.Error("Reals with a null element: no error")
+	}
+}
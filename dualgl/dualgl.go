@@ -0,0 +1,74 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+// Package dualgl converts between the unit dual quaternions of package dual,
+// as dual.Hamilton values, and the float32 types of the mgl32 package of
+// go-gl/mathgl, for OpenGL programs.
+//
+// A rigid motion is the rotation r followed by the translation t, encoded as
+// the unit dual quaternion r + ½trε, as in dual.Hamilton.Compose. In mgl32, it
+// is the rotation as an mgl32.Quat together with the translation as an
+// mgl32.Vec3, or the 4×4 homogeneous matrix T·R as an mgl32.Mat4. For
+// dual-quaternion skinning, QuatPair gives the real and dual parts as two
+// mgl32.Quat values, ready to be uploaded as two vec4 uniforms.
+package dualgl
+
+import (
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/meirizarrygelpi/dual"
+)
+
+// quat returns the quaternion with the components w, x, y, and z as an
+// mgl32.Quat.
+func quat(w, x, y, z float64) mgl32.Quat {
+	return mgl32.Quat{W: float32(w), V: mgl32.Vec3{float32(x), float32(y), float32(z)}}
+}
+
+// QuatPair returns the real and dual quaternion parts of q as mgl32.Quat
+// values.
+func QuatPair(q *dual.Hamilton) (r, d mgl32.Quat) {
+	a, b, c, e, f, g, h, k := q.Cartesian()
+	return quat(a, b, c, e), quat(f, g, h, k)
+}
+
+// FromQuatPair returns a pointer to the Hamilton value with the real and dual
+// quaternion parts r and d.
+func FromQuatPair(r, d mgl32.Quat) *dual.Hamilton {
+	return dual.NewHamilton(
+		float64(r.W), float64(r.V[0]), float64(r.V[1]), float64(r.V[2]),
+		float64(d.W), float64(d.V[0]), float64(d.V[1]), float64(d.V[2]),
+	)
+}
+
+// Quat returns the rotation and the translation of the unit dual quaternion
+// q, as an mgl32.Quat and an mgl32.Vec3.
+func Quat(q *dual.Hamilton) (mgl32.Quat, mgl32.Vec3) {
+	a, b, c, d, _, _, _, _ := q.Cartesian()
+	// Composing with the inverse rotation leaves the translation 1 + ½tε.
+	p := new(dual.Hamilton).QuatConj(dual.NewHamilton(a, b, c, d, 0, 0, 0, 0))
+	p.Compose(q, p)
+	_, t := p.VectorPart()
+	return quat(a, b, c, d), mgl32.Vec3{float32(2 * t[0]), float32(2 * t[1]), float32(2 * t[2])}
+}
+
+// FromQuat returns a pointer to the unit dual quaternion of the rotation r
+// followed by the translation t. The rotation is normalized.
+func FromQuat(r mgl32.Quat, t mgl32.Vec3) *dual.Hamilton {
+	r = r.Normalize()
+	q := dual.NewHamilton(float64(r.W), float64(r.V[0]), float64(r.V[1]), float64(r.V[2]), 0, 0, 0, 0)
+	v := [3]float64{float64(t[0]), float64(t[1]), float64(t[2])}
+	return q.Compose(dual.HamiltonTranslation(v), q)
+}
+
+// Mat4 returns the homogeneous transformation matrix of the unit dual
+// quaternion q, in the column-major layout of mgl32.
+func Mat4(q *dual.Hamilton) mgl32.Mat4 {
+	r, t := Quat(q)
+	return mgl32.Translate3D(t[0], t[1], t[2]).Mul4(r.Mat4())
+}
+
+// FromMat4 returns a pointer to the unit dual quaternion of the rigid motion
+// with homogeneous matrix m. The upper-left 3×3 block of m must be a rotation.
+func FromMat4(m mgl32.Mat4) *dual.Hamilton {
+	return FromQuat(mgl32.Mat4ToQuat(m), mgl32.Vec3{m[12], m[13], m[14]})
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dualgl
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-gl/mathgl/mgl32"
+	"github.com/meirizarrygelpi/dual"
+)
+
+const tol = 1e-6
+
+func TestConversions(t *testing.T) {
+	tr := [3]float64{1, -2, 3}
+	q := new(dual.Hamilton).Compose(dual.HamiltonTranslation(tr), dual.HamiltonRotation([3]float64{0, 0, 1}, math.Pi/2))
+	r, v := Quat(q)
+	if want := (mgl32.Vec3{1, -2, 3}); !v.ApproxEqualThreshold(want, tol) {
+		t.Errorf("translation = %v, want %v", v, want)
+	}
+	if got := FromQuat(r, v); !got.EqualsTol(q, tol) {
+		t.Errorf("FromQuat = %v, want %v", got, q)
+	}
+	// The matrix takes the x axis to the y axis, then translates it.
+	m := Mat4(q)
+	if got, want := m.Mul4x1(mgl32.Vec4{1, 0, 0, 1}), (mgl32.Vec4{1, -1, 3, 1}); !got.ApproxEqualThreshold(want, tol) {
+		t.Errorf("transformed point = %v, want %v", got, want)
+	}
+	if got := FromMat4(m); !got.EqualsTol(q, tol) {
+		t.Errorf("FromMat4 = %v, want %v", got, q)
+	}
+	a, b := QuatPair(q)
+	if got := FromQuatPair(a, b); !got.EqualsTol(q, tol) {
+		t.Errorf("FromQuatPair = %v, want %v", got, q)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "math"
+
+// A DualSlice represents a sequence of dual numbers in structure-of-arrays
+// form: the real parts are stored contiguously in Real, and the dual parts in
+// Dual. The two slices must have the same length. Each loop over a DualSlice
+// works on plain float64 slices, which suits bulk arithmetic better than the
+// interleaved layout of a DualVector.
+//
+// The methods that set a DualSlice require all the slices involved to have the
+// same length, and panic otherwise. The result may alias any operand.
+type DualSlice struct {
+	Real, Dual []float64
+}
+
+// NewDualSlice returns a DualSlice of n zeros.
+func NewDualSlice(n int) DualSlice {
+	return DualSlice{make([]float64, n), make([]float64, n)}
+}
+
+// Len returns the number of dual numbers in z.
+func (z DualSlice) Len() int {
+	return len(z.Real)
+}
+
+// At returns the element of z at index i, a pointer to a new Real value.
+func (z DualSlice) At(i int) *Real {
+	return NewReal(z.Real[i], z.Dual[i])
+}
+
+// SetAt sets the element of z at index i equal to x.
+func (z DualSlice) SetAt(i int, x *Real) {
+	z.Real[i], z.Dual[i] = x.Cartesian()
+}
+
+// FromVector sets the elements of z equal to those of v, and returns z.
+func (z DualSlice) FromVector(v DualVector) DualSlice {
+	checkLen(len(z.Real), len(z.Dual), len(v))
+	for i := range v {
+		z.Real[i], z.Dual[i] = v[i].Cartesian()
+	}
+	return z
+}
+
+// ToVector sets the components of v equal to the elements of z, and returns v.
+func (z DualSlice) ToVector(v DualVector) DualVector {
+	checkLen(len(z.Real), len(z.Dual), len(v))
+	for i := range v {
+		v[i].Set(z.Real[i], z.Dual[i])
+	}
+	return v
+}
+
+// Add sets each element of z equal to the sum of the matching elements of x
+// and y, and returns z.
+func (z DualSlice) Add(x, y DualSlice) DualSlice {
+	n := z.check(x, y)
+	addFloats(z.Real[:n], x.Real[:n], y.Real[:n])
+	addFloats(z.Dual[:n], x.Dual[:n], y.Dual[:n])
+	return z
+}
+
+// Sub sets each element of z equal to the difference of the matching elements
+// of x and y, and returns z.
+func (z DualSlice) Sub(x, y DualSlice) DualSlice {
+	n := z.check(x, y)
+	subFloats(z.Real[:n], x.Real[:n], y.Real[:n])
+	subFloats(z.Dual[:n], x.Dual[:n], y.Dual[:n])
+	return z
+}
+
+// Mul sets each element of z equal to the product of the matching elements of
+// x and y, and returns z. The result matches Real.Mul element by element.
+func (z DualSlice) Mul(x, y DualSlice) DualSlice {
+	n := z.check(x, y)
+	mulDual(z.Real[:n], z.Dual[:n], x.Real[:n], x.Dual[:n], y.Real[:n], y.Dual[:n])
+	return z
+}
+
+// Scale sets each element of z equal to the matching element of y multiplied
+// by a, and returns z.
+func (z DualSlice) Scale(y DualSlice, a *Real) DualSlice {
+	n := z.check(y)
+	c, d := a.Cartesian()
+	scaleDual(z.Real[:n], z.Dual[:n], y.Real[:n], y.Dual[:n], c, d)
+	return z
+}
+
+// Exp sets each element of z equal to the dual exponential of the matching
+// element of y, and returns z.
+func (z DualSlice) Exp(y DualSlice) DualSlice {
+	n := z.check(y)
+	zr, zd := z.Real[:n], z.Dual[:n]
+	yr, yd := y.Real[:n], y.Dual[:n]
+	for i := range zr {
+		e := math.Exp(yr[i])
+		zr[i] = e
+		zd[i] = yd[i] * e
+	}
+	return z
+}
+
+// check panics if the real and dual parts of z and of each of x do not all
+// have the same length, and otherwise returns that length.
+func (z DualSlice) check(x ...DualSlice) int {
+	n := len(z.Real)
+	checkLen(n, len(z.Dual))
+	for _, y := range x {
+		checkLen(n, len(y.Real), len(y.Dual))
+	}
+	return n
+}
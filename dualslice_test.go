@@ -0,0 +1,88 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDualSlice(t *testing.T) {
+	x := DualVector{{3, 1}, {4, 2}, {0.5, -1}}
+	y := DualVector{{1, 0}, {2, 1}, {-2, 3}}
+	xs := NewDualSlice(3).FromVector(x)
+	ys := NewDualSlice(3).FromVector(y)
+	z := NewDualSlice(3)
+	v := make(DualVector, 3)
+	if got, want := z.Add(xs, ys).ToVector(v), make(DualVector, 3).Add(x, y); !got.Equals(want) {
+		t.Errorf("Add = %v, want %v", got, want)
+	}
+	if got, want := z.Sub(xs, ys).ToVector(v), make(DualVector, 3).Sub(x, y); !got.Equals(want) {
+		t.Errorf("Sub = %v, want %v", got, want)
+	}
+	a := NewReal(2, 1)
+	if got, want := z.Scale(xs, a).ToVector(v), make(DualVector, 3).Scale(x, a); !got.Equals(want) {
+		t.Errorf("Scale = %v, want %v", got, want)
+	}
+	z.Mul(xs, ys)
+	for i := range x {
+		if got, want := z.At(i), new(Real).Mul(&x[i], &y[i]); !got.Equal(want) {
+			t.Errorf("Mul[%d] = %v, want %v", i, got, want)
+		}
+	}
+	z.Exp(z.Mul(z, xs))
+	for i := range x {
+		w := new(Real).Mul(&x[i], &y[i])
+		if got, want := z.At(i), new(Real).Exp(w.Mul(w, &x[i])); !got.Equals(want) {
+			t.Errorf("Exp[%d] = %v, want %v", i, got, want)
+		}
+	}
+	z.SetAt(1, NewReal(7, 8))
+	if z.Real[1] != 7 || z.Dual[1] != 8 || z.Len() != 3 {
+		t.Errorf("SetAt = %v, %v", z.Real, z.Dual)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Add with mismatched lengths did not panic")
+		}
+	}()
+	z.Add(xs, NewDualSlice(2))
+}
+
+func TestDualSliceNonFinite(t *testing.T) {
+	inf, nan := math.Inf(1), math.NaN()
+	x := DualVector{{inf, 0}, {1, nan}, {0, 0}, {1, inf}, {inf, 0}}
+	y := DualVector{{1, 0}, {0, 0}, {inf, 1}, {0, 0}, {nan, 0}}
+	z := NewDualSlice(len(x)).Mul(NewDualSlice(len(x)).FromVector(x), NewDualSlice(len(y)).FromVector(y))
+	for i := range x {
+		want := new(Real).Mul(&x[i], &y[i])
+		if got := z.At(i); !floatsEqual(got[:], want[:]) {
+			t.Errorf("Mul[%d] = %v, want Real.Mul = %v", i, got, want)
+		}
+	}
+	if got := z.At(0); !got.Equal(NewReal(inf, 0)) {
+		t.Errorf("(∞+0ε)·(1+0ε) = %v, want (+Inf+0ε)", got)
+	}
+	a := NewReal(0, 0)
+	z.Scale(NewDualSlice(len(x)).FromVector(x), a)
+	for i := range x {
+		want := new(Real).Mul(&x[i], a)
+		if got := z.At(i); !floatsEqual(got[:], want[:]) {
+			t.Errorf("Scale[%d] = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func BenchmarkDualSliceMul(b *testing.B) {
+	const n = 1024
+	x, y, z := NewDualSlice(n), NewDualSlice(n), NewDualSlice(n)
+	for i := 0; i < n; i++ {
+		x.Real[i], x.Dual[i] = float64(i), 1
+		y.Real[i], y.Dual[i] = 2, float64(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		z.Mul(x, y)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+// Package dualtest checks algebraic laws, such as associativity and
+// distributivity, over random samples of the number types of package dual or
+// of any type with the same method shapes.
+//
+// The operations are passed as method expressions, and the samples come from a
+// generator with the signature of the dual Rand constructors:
+// 		err := dualtest.CheckAssociative(dual.RandReal, (*dual.Real).Mul, 1e-12)
+// Each check returns nil if the law holds for every sample within the given
+// absolute tolerance, and a *CheckError describing the first counterexample
+// otherwise. The samples are drawn from a source seeded with Seed, so a failure
+// is reproducible.
+package dualtest
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Samples is the number of random samples tried by each check.
+var Samples = 100
+
+// Seed is the seed of the random source used by each check.
+var Seed int64 = 1
+
+// Number is the constraint satisfied by pointer types P that compare against
+// another P within a tolerance, as the number types of package dual do.
+type Number[P any] interface {
+	EqualsTol(y P, tol float64) bool
+}
+
+// Gen returns a random value with components of magnitude at most scale. The
+// dual Rand constructors, such as dual.RandReal, are Gen values.
+type Gen[P any] func(r *rand.Rand, scale float64) P
+
+// Unary sets z equal to an operation on y, and returns z, as in
+// (*dual.Real).Conj.
+type Unary[P any] func(z, y P) P
+
+// Binary sets z equal to an operation on x and y, and returns z, as in
+// (*dual.Real).Mul.
+type Binary[P any] func(z, x, y P) P
+
+// A CheckError is the error returned by a failed check. It records the law, the
+// sample on which it failed, and the values of the two sides.
+type CheckError struct {
+	Law         string
+	Count       int
+	In          []any
+	Left, Right any
+}
+
+// Error returns a string describing e.
+func (e *CheckError) Error() string {
+	return fmt.Sprintf("dualtest: %s fails on sample #%d %v: %v != %v",
+		e.Law, e.Count, e.In, e.Left, e.Right)
+}
+
+// check draws n operands at a time from gen and compares the two sides of a
+// law on each sample. The sides are computed into fresh destinations, also
+// drawn from gen, so that types such as dual.Perplex, whose zero value is not
+// usable, are handled.
+func check[P Number[P]](law string, gen Gen[P], n int, tol float64, sides func(in []P, dst func() P) (P, P)) error {
+	r := rand.New(rand.NewSource(Seed))
+	dst := func() P { return gen(r, 1) }
+	for i := 0; i < Samples; i++ {
+		in := make([]P, n)
+		for j := range in {
+			in[j] = gen(r, 1)
+		}
+		a, b := sides(in, dst)
+		if !a.EqualsTol(b, tol) {
+			e := &CheckError{Law: law, Count: i, Left: a, Right: b}
+			for _, x := range in {
+				e.In = append(e.In, x)
+			}
+			return e
+		}
+	}
+	return nil
+}
+
+// CheckAssociative checks that (xy)w = x(yw) for the operation op.
+func CheckAssociative[P Number[P]](gen Gen[P], op Binary[P], tol float64) error {
+	return check("associativity", gen, 3, tol, func(in []P, dst func() P) (P, P) {
+		x, y, w := in[0], in[1], in[2]
+		return op(dst(), op(dst(), x, y), w), op(dst(), x, op(dst(), y, w))
+	})
+}
+
+// CheckCommutative checks that xy = yx for the operation op.
+func CheckCommutative[P Number[P]](gen Gen[P], op Binary[P], tol float64) error {
+	return check("commutativity", gen, 2, tol, func(in []P, dst func() P) (P, P) {
+		x, y := in[0], in[1]
+		return op(dst(), x, y), op(dst(), y, x)
+	})
+}
+
+// CheckDistributive checks that mul distributes over add on both sides, that
+// is, x(y + w) = xy + xw and (x + y)w = xw + yw.
+func CheckDistributive[P Number[P]](gen Gen[P], mul, add Binary[P], tol float64) error {
+	err := check("left distributivity", gen, 3, tol, func(in []P, dst func() P) (P, P) {
+		x, y, w := in[0], in[1], in[2]
+		return mul(dst(), x, add(dst(), y, w)), add(dst(), mul(dst(), x, y), mul(dst(), x, w))
+	})
+	if err != nil {
+		return err
+	}
+	return check("right distributivity", gen, 3, tol, func(in []P, dst func() P) (P, P) {
+		x, y, w := in[0], in[1], in[2]
+		return mul(dst(), add(dst(), x, y), w), add(dst(), mul(dst(), x, w), mul(dst(), y, w))
+	})
+}
+
+// CheckInvolution checks that f(f(x)) = x for the operation f.
+func CheckInvolution[P Number[P]](gen Gen[P], f Unary[P], tol float64) error {
+	return check("involution", gen, 1, tol, func(in []P, dst func() P) (P, P) {
+		return f(dst(), f(dst(), in[0])), in[0]
+	})
+}
+
+// CheckAutomorphism checks that f(xy) = f(x)f(y) for the operation f and the
+// product mul.
+func CheckAutomorphism[P Number[P]](gen Gen[P], f Unary[P], mul Binary[P], tol float64) error {
+	return check("automorphism", gen, 2, tol, func(in []P, dst func() P) (P, P) {
+		x, y := in[0], in[1]
+		return f(dst(), mul(dst(), x, y)), mul(dst(), f(dst(), x), f(dst(), y))
+	})
+}
+
+// CheckAntiAutomorphism checks that f(xy) = f(y)f(x) for the operation f and
+// the product mul, as for a conjugate.
+func CheckAntiAutomorphism[P Number[P]](gen Gen[P], f Unary[P], mul Binary[P], tol float64) error {
+	return check("anti-automorphism", gen, 2, tol, func(in []P, dst func() P) (P, P) {
+		x, y := in[0], in[1]
+		return f(dst(), mul(dst(), x, y)), mul(dst(), f(dst(), y), f(dst(), x))
+	})
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dualtest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/meirizarrygelpi/dual"
+)
+
+const tol = 1e-12
+
+func TestLaws(t *testing.T) {
+	for _, err := range []error{
+		CheckAssociative(dual.RandReal, (*dual.Real).Mul, tol),
+		CheckCommutative(dual.RandReal, (*dual.Real).Mul, tol),
+		CheckDistributive(dual.RandReal, (*dual.Real).Mul, (*dual.Real).Add, tol),
+		CheckInvolution(dual.RandReal, (*dual.Real).Conj, tol),
+		CheckAssociative(dual.RandComplex, (*dual.Complex).Mul, tol),
+		CheckDistributive(dual.RandComplex, (*dual.Complex).Mul, (*dual.Complex).Add, tol),
+		CheckInvolution(dual.RandComplex, (*dual.Complex).Conj, tol),
+		CheckAssociative(dual.RandPerplex, (*dual.Perplex).Mul, tol),
+		CheckDistributive(dual.RandPerplex, (*dual.Perplex).Mul, (*dual.Perplex).Add, tol),
+		CheckInvolution(dual.RandPerplex, (*dual.Perplex).Conj, tol),
+		CheckDistributive(dual.RandHamilton, (*dual.Hamilton).Mul, (*dual.Hamilton).Add, tol),
+		CheckInvolution(dual.RandHamilton, (*dual.Hamilton).Conj, tol),
+		CheckAntiAutomorphism(dual.RandHamilton, (*dual.Hamilton).Conj, (*dual.Hamilton).Mul, tol),
+		CheckAutomorphism(dual.RandHamilton, (*dual.Hamilton).DualConj, (*dual.Hamilton).Mul, tol),
+		CheckAssociative(dual.RandHamilton, (*dual.Hamilton).Compose, tol),
+		CheckDistributive(dual.RandHamilton, (*dual.Hamilton).Compose, (*dual.Hamilton).Add, tol),
+		CheckInvolution(dual.RandHamilton, (*dual.Hamilton).QuatConj, tol),
+		CheckInvolution(dual.RandHamilton, (*dual.Hamilton).DualConj, tol),
+		CheckInvolution(dual.RandHamilton, (*dual.Hamilton).QuatDualConj, tol),
+		CheckAntiAutomorphism(dual.RandHamilton, (*dual.Hamilton).QuatConj, (*dual.Hamilton).Compose, tol),
+		CheckAutomorphism(dual.RandHamilton, (*dual.Hamilton).DualConj, (*dual.Hamilton).Compose, tol),
+		CheckAntiAutomorphism(dual.RandHamilton, (*dual.Hamilton).QuatDualConj, (*dual.Hamilton).Compose, tol),
+		CheckCommutative(dual.RandHyper, (*dual.Hyper).Add, tol),
+	} {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestCheckError(t *testing.T) {
+	for _, err := range []error{
+		CheckCommutative(dual.RandComplex, (*dual.Complex).Mul, tol),
+		CheckAssociative(dual.RandHamilton, (*dual.Hamilton).Mul, tol),
+		CheckAntiAutomorphism(dual.RandHamilton, (*dual.Hamilton).QuatConj, (*dual.Hamilton).Mul, tol),
+	} {
+		var e *CheckError
+		if !errors.As(err, &e) {
+			t.Errorf("err = %v, want a *CheckError", err)
+			continue
+		}
+		if e.Law == "" || len(e.In) == 0 {
+			t.Errorf("incomplete CheckError %+v", e)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "sort"
+
+// ScLERP sets z equal to the screw linear interpolation from the unit dual
+// quaternion x to the unit dual quaternion y at the parameter t, and returns
+// z:
+// 		ScLERP(x, y, t) = x(x⁻¹y)ᵗ
+// The motion is along the screw from x to y at a constant rate, by the shortest
+// path, with t = 0 giving x and t = 1 giving y.
+func (z *Hamilton) ScLERP(x, y *Hamilton, t float64) *Hamilton {
+	inv := inverseMotion(x)
+	rel := compose(&inv, y)
+	w, v := logMotion(&rel)
+	e := expTwist(scale3(w, t), scale3(v, t))
+	*z = compose(x, &e)
+	return z
+}
+
+// An Easing maps the parameter t in [0, 1] to an eased parameter, with 0 at 0
+// and 1 at 1.
+type Easing func(t float64) float64
+
+// Linear is the identity Easing.
+func Linear(t float64) float64 {
+	return t
+}
+
+// Smoothstep is the Easing 3t² - 2t³, which starts and ends at rest.
+func Smoothstep(t float64) float64 {
+	return t * t * (3 - (2 * t))
+}
+
+// CubicInOut is the Easing that is 4t³ for t < ½ and 1 - 4(1-t)³ after it: it
+// accelerates over the first half and decelerates over the second.
+func CubicInOut(t float64) float64 {
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	s := 1 - t
+	return 1 - (4 * s * s * s)
+}
+
+// A Keyframe is a pose, a unit dual quaternion, at a time.
+type Keyframe struct {
+	Time float64
+	Pose Hamilton
+}
+
+// Keyframes is a sequence of keyframes, sorted by time, for camera paths and
+// animation.
+type Keyframes []Keyframe
+
+// Eval returns the pose of k at the time t, a pointer to a Hamilton value. The
+// pose is the ScLERP between the keyframes before and after t, with the
+// fraction of the time between them passed through the Easing e. A nil e is
+// Linear. Before the first keyframe and after the last, the pose is held. If k
+// is empty, then Eval panics.
+func (k Keyframes) Eval(t float64, e Easing) *Hamilton {
+	if len(k) == 0 {
+		panic("no keyframes")
+	}
+	if e == nil {
+		e = Linear
+	}
+	i := sort.Search(len(k), func(i int) bool { return k[i].Time > t })
+	z := new(Hamilton)
+	switch {
+	case i == 0:
+		*z = k[0].Pose
+	case i == len(k):
+		*z = k[len(k)-1].Pose
+	default:
+		a, b := &k[i-1], &k[i]
+		z.ScLERP(&a.Pose, &b.Pose, e((t-a.Time)/(b.Time-a.Time)))
+	}
+	return z
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"testing"
+)
+
+func TestScLERP(t *testing.T) {
+	const tol = 1e-12
+	x := motion([3]float64{0, 0, 1}, 0.3, [3]float64{1, 0, 0})
+	y := motion([3]float64{0, 0.6, 0.8}, 1.5, [3]float64{2, -1, 3})
+	if got := new(Hamilton).ScLERP(x, y, 0); !got.EqualsTol(x, tol) {
+		t.Errorf("ScLERP at 0 = %v, want %v", got, x)
+	}
+	if got := new(Hamilton).ScLERP(x, y, 1); !got.EqualsTol(y, tol) {
+		t.Errorf("ScLERP at 1 = %v, want %v", got, y)
+	}
+	// Halfway twice along the same screw is the whole way.
+	m := new(Hamilton).ScLERP(x, y, 0.5)
+	if got := new(Hamilton).ScLERP(x, m, 2); !got.EqualsTol(y, 1e-9) {
+		t.Errorf("ScLERP(x, m, 2) = %v, want %v", got, y)
+	}
+	// A rotation about a fixed axis keeps its angle halfway.
+	a := motion([3]float64{0, 0, 1}, 0, [3]float64{0, 0, 0})
+	b := motion([3]float64{0, 0, 1}, 1, [3]float64{0, 0, 0})
+	if got, want := new(Hamilton).ScLERP(a, b, 0.5), motion([3]float64{0, 0, 1}, 0.5, [3]float64{0, 0, 0}); !got.EqualsTol(want, tol) {
+		t.Errorf("ScLERP halfway = %v, want %v", got, want)
+	}
+}
+
+func TestEasing(t *testing.T) {
+	for _, e := range []Easing{Linear, Smoothstep, CubicInOut} {
+		if e(0) != 0 || e(1) != 1 || math.Abs(e(0.5)-0.5) > 1e-15 {
+			t.Errorf("easing ends are %v, %v, and %v at ½", e(0), e(1), e(0.5))
+		}
+	}
+}
+
+func TestKeyframes(t *testing.T) {
+	const tol = 1e-12
+	u := [3]float64{1, 0, 0}
+	k := Keyframes{
+		{0, *motion(u, 0, [3]float64{0, 0, 0})},
+		{1, *motion(u, 1, [3]float64{1, 0, 0})},
+		{3, *motion(u, 2, [3]float64{2, 0, 0})},
+	}
+	for _, test := range []struct {
+		t    float64
+		e    Easing
+		want *Hamilton
+	}{
+		{-1, nil, &k[0].Pose},
+		{0.5, nil, motion(u, 0.5, [3]float64{0.5, 0, 0})},
+		{2, Smoothstep, motion(u, 1.5, [3]float64{1.5, 0, 0})},
+		{1.5, Smoothstep, motion(u, 1.15625, [3]float64{1.15625, 0, 0})},
+		{4, CubicInOut, &k[2].Pose},
+	} {
+		if got := k.Eval(test.t, test.e); !got.EqualsTol(test.want, tol) {
+			t.Errorf("Eval(%v) = %v, want %v", test.t, got, test.want)
+		}
+	}
+}
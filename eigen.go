@@ -0,0 +1,129 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"sort"
+)
+
+// EigenSym returns the dual eigenvalues of the symmetric DualMatrix a, in
+// increasing order of their real parts, as a DualVector value. If a = A + εB,
+// then the real parts are the eigenvalues of A and the dual parts are their
+// first-order sensitivities along B. For a simple eigenvalue λ with unit
+// eigenvector v, the sensitivity is vᵀBv. For a repeated eigenvalue, the
+// sensitivities are the eigenvalues of B restricted to its eigenspace, in
+// increasing order. If a is not square, or if it is not symmetric, then
+// EigenSym panics.
+func EigenSym(a *DualMatrix) DualVector {
+	if a.rows != a.cols {
+		panic("non-square matrix")
+	}
+	n := a.rows
+	p := make([]float64, n*n)
+	q := make([]float64, n*n)
+	for i := range p {
+		p[i], q[i] = a.data[i].Cartesian()
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			if notEquals(p[i*n+j], p[j*n+i]) || notEquals(q[i*n+j], q[j*n+i]) {
+				panic("non-symmetric matrix")
+			}
+		}
+	}
+	λ, v := jacobiEigen(p, n)
+	z := make(DualVector, n)
+	scale := 1.0
+	for _, l := range λ {
+		scale = math.Max(scale, math.Abs(l))
+	}
+	for i := 0; i < n; {
+		// Group the eigenvalues equal to λ[i].
+		j := i + 1
+		for j < n && λ[j]-λ[i] <= delta*scale {
+			j++
+		}
+		// Restrict B to the eigenspace spanned by the columns i to j-1 of v.
+		k := j - i
+		m := make([]float64, k*k)
+		for r := 0; r < k; r++ {
+			for c := 0; c < k; c++ {
+				var s float64
+				for x := 0; x < n; x++ {
+					for y := 0; y < n; y++ {
+						s += v[x*n+i+r] * q[x*n+y] * v[y*n+i+c]
+					}
+				}
+				m[r*k+c] = s
+			}
+		}
+		μ, _ := jacobiEigen(m, k)
+		for r := 0; r < k; r++ {
+			z[i+r] = Real{λ[i+r], μ[r]}
+		}
+		i = j
+	}
+	return z
+}
+
+// jacobiEigen returns the eigenvalues of the symmetric n×n matrix a of float64
+// values, in row-major order, in increasing order, together with the matching
+// unit eigenvectors as the columns of an n×n matrix. It uses the cyclic Jacobi
+// method, and a is overwritten.
+func jacobiEigen(a []float64, n int) ([]float64, []float64) {
+	v := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		v[i*n+i] = 1
+	}
+	for sweep := 0; sweep < 100; sweep++ {
+		var off float64
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				off += a[i*n+j] * a[i*n+j]
+			}
+		}
+		if off == 0 {
+			break
+		}
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				if a[p*n+q] == 0 {
+					continue
+				}
+				θ := (a[q*n+q] - a[p*n+p]) / (2 * a[p*n+q])
+				t := math.Copysign(1, θ) / (math.Abs(θ) + math.Hypot(θ, 1))
+				c := 1 / math.Hypot(t, 1)
+				s := t * c
+				for k := 0; k < n; k++ {
+					// Rotate columns p and q, then rows p and q.
+					akp, akq := a[k*n+p], a[k*n+q]
+					a[k*n+p], a[k*n+q] = c*akp-s*akq, s*akp+c*akq
+				}
+				for k := 0; k < n; k++ {
+					apk, aqk := a[p*n+k], a[q*n+k]
+					a[p*n+k], a[q*n+k] = c*apk-s*aqk, s*apk+c*aqk
+				}
+				for k := 0; k < n; k++ {
+					vkp, vkq := v[k*n+p], v[k*n+q]
+					v[k*n+p], v[k*n+q] = c*vkp-s*vkq, s*vkp+c*vkq
+				}
+			}
+		}
+	}
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool { return a[idx[i]*n+idx[i]] < a[idx[j]*n+idx[j]] })
+	λ := make([]float64, n)
+	w := make([]float64, n*n)
+	for c, i := range idx {
+		λ[c] = a[i*n+i]
+		for r := 0; r < n; r++ {
+			w[r*n+c] = v[r*n+i]
+		}
+	}
+	return λ, w
+}
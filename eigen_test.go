@@ -0,0 +1,20 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "testing"
+
+func TestEigenSym(t *testing.T) {
+	// A = [[2 1] [1 2]] has eigenvalues 1 and 3, with eigenvectors (1, -1)/√2
+	// and (1, 1)/√2. With B = [[1 0] [0 0]], the sensitivities are both ½.
+	a := NewDualMatrix(2, 2, []Real{{2, 1}, {1, 0}, {1, 0}, {2, 0}})
+	if got, want := EigenSym(a), (DualVector{{1, 0.5}, {3, 0.5}}); !got.EqualsTol(want, 1e-12) {
+		t.Errorf("EigenSym = %v, want %v", got, want)
+	}
+	// A repeated eigenvalue splits along the eigenvalues of B.
+	b := NewDualMatrix(3, 3, []Real{{1, 0}, {0, 2}, {0, 0}, {0, 2}, {1, 0}, {0, 0}, {0, 0}, {0, 0}, {5, 1}})
+	if got, want := EigenSym(b), (DualVector{{1, -2}, {1, 2}, {5, 1}}); !got.EqualsTol(want, 1e-12) {
+		t.Errorf("EigenSym = %v, want %v", got, want)
+	}
+}
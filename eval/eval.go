@@ -0,0 +1,466 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+// Package eval parses and evaluates arithmetic expressions in the dual numbers
+// of package dual, such as
+// 		(1+2ε)*(3-ε)/x
+// with the variables bound to dual.Real values. EvalComplex and EvalHamilton
+// evaluate expressions in the values of dual.Complex and dual.Hamilton.
+//
+// An expression is made of numbers, units, variables, the operators +, -, *,
+// and /, parentheses, and calls to the one-argument functions of the type, such
+// as sin, exp, sqrt, and log for dual.Real, in lower case. The unit of
+// dual.Real is ε, the units of dual.Complex are i, ε, and εi, and those of
+// dual.Hamilton are i, j, k, ε, εi, εj, and εk, with ε also spelled "eps". A
+// number followed at once by a unit, as in "2ε" or "2eps", is a multiple of it.
+// The operators have the usual precedence, with unary minus binding tightest,
+// and * and / group to the left. A variable is a letter or underscore followed
+// by letters, digits, and underscores, other than a unit.
+package eval
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/meirizarrygelpi/dual"
+)
+
+// A number is the pointer type of the values of package dual that an
+// expression is evaluated in.
+type number[T any] interface {
+	*T
+	Add(x, y *T) *T
+	Sub(x, y *T) *T
+	Mul(x, y *T) *T
+	Neg(y *T) *T
+	QuoChecked(x, y *T) (*T, error)
+	Copy(y *T) *T
+}
+
+// An algebra describes the values of type T to the parser.
+type algebra[T any] struct {
+	n     int                         // number of components
+	value func(v []float64) *T        // value with the components v
+	units map[string]int              // index of the component of each unit
+	funcs map[string]func(z, y *T) *T // functions that an expression can call
+}
+
+// basis returns the value with a as the component i, and zero elsewhere.
+func (alg *algebra[T]) basis(i int, a float64) *T {
+	v := make([]float64, alg.n)
+	v[i] = a
+	return alg.value(v)
+}
+
+// A node evaluates a parsed subexpression with the variables in vars.
+type node[T any] func(vars map[string]*T) (*T, error)
+
+// An Expr is a parsed expression, ready to be evaluated many times.
+type Expr struct {
+	src  string
+	root node[dual.Real]
+}
+
+var realAlgebra = &algebra[dual.Real]{
+	n: 2,
+	value: func(v []float64) *dual.Real {
+		return dual.NewReal(v[0], v[1])
+	},
+	units: map[string]int{"ε": 1, "eps": 1},
+	funcs: realFuncs,
+}
+
+var complexAlgebra = &algebra[dual.Complex]{
+	n: 4,
+	value: func(v []float64) *dual.Complex {
+		return dual.NewComplex(v[0], v[1], v[2], v[3])
+	},
+	units: map[string]int{"i": 1, "ε": 2, "eps": 2, "εi": 3, "epsi": 3},
+	funcs: complexFuncs,
+}
+
+var hamiltonAlgebra = &algebra[dual.Hamilton]{
+	n: 8,
+	value: func(v []float64) *dual.Hamilton {
+		return dual.NewHamilton(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+	},
+	units: map[string]int{
+		"i": 1, "j": 2, "k": 3,
+		"ε": 4, "εi": 5, "εj": 6, "εk": 7,
+		"eps": 4, "epsi": 5, "epsj": 6, "epsk": 7,
+	},
+}
+
+// complexFuncs holds the functions that an expression in dual.Complex values
+// can call.
+var complexFuncs = map[string]func(z, y *dual.Complex) *dual.Complex{
+	"sin":  (*dual.Complex).Sin,
+	"cos":  (*dual.Complex).Cos,
+	"sinh": (*dual.Complex).Sinh,
+	"cosh": (*dual.Complex).Cosh,
+	"tan":  (*dual.Complex).Tan,
+	"tanh": (*dual.Complex).Tanh,
+	"asin": (*dual.Complex).Asin,
+	"acos": (*dual.Complex).Acos,
+	"atan": (*dual.Complex).Atan,
+}
+
+// realFuncs holds the functions that an expression in dual.Real values can
+// call.
+var realFuncs = map[string]func(z, y *dual.Real) *dual.Real{
+	"sin":   (*dual.Real).Sin,
+	"cos":   (*dual.Real).Cos,
+	"exp":   (*dual.Real).Exp,
+	"sinh":  (*dual.Real).Sinh,
+	"cosh":  (*dual.Real).Cosh,
+	"tan":   (*dual.Real).Tan,
+	"tanh":  (*dual.Real).Tanh,
+	"sqrt":  (*dual.Real).Sqrt,
+	"log":   (*dual.Real).Log,
+	"asin":  (*dual.Real).Asin,
+	"acos":  (*dual.Real).Acos,
+	"atan":  (*dual.Real).Atan,
+	"abs":   (*dual.Real).Abs,
+	"log2":  (*dual.Real).Log2,
+	"log10": (*dual.Real).Log10,
+	"exp2":  (*dual.Real).Exp2,
+	"exp10": (*dual.Real).Exp10,
+	"cbrt":  (*dual.Real).Cbrt,
+}
+
+// Parse parses s as an expression. The error of a malformed expression gives
+// the byte offset in s at which parsing failed.
+func Parse(s string) (*Expr, error) {
+	n, err := parse(s, realAlgebra)
+	if err != nil {
+		return nil, err
+	}
+	return &Expr{src: s, root: n}, nil
+}
+
+// parse parses s as an expression in the values of alg.
+func parse[T any, P number[T]](s string, alg *algebra[T]) (node[T], error) {
+	p := &parser[T, P]{src: s, alg: alg}
+	n, err := p.sum()
+	if err != nil {
+		return nil, err
+	}
+	p.skip()
+	if p.pos < len(s) {
+		return nil, p.unexpected()
+	}
+	return n, nil
+}
+
+// Eval returns the value of e with the variables in vars. It returns an error
+// if e uses a variable that is not in vars, or if it divides by a zero divisor,
+// in which case the error is dual.ErrZeroDivisor.
+func (e *Expr) Eval(vars map[string]*dual.Real) (*dual.Real, error) {
+	return e.root(vars)
+}
+
+// String returns the source of e.
+func (e *Expr) String() string {
+	return e.src
+}
+
+// Eval parses s and evaluates it with the variables in vars.
+func Eval(s string, vars map[string]*dual.Real) (*dual.Real, error) {
+	e, err := Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	return e.Eval(vars)
+}
+
+// EvalComplex parses s and evaluates it in dual.Complex values, with the
+// variables in vars. Its errors are those of Eval.
+func EvalComplex(s string, vars map[string]*dual.Complex) (*dual.Complex, error) {
+	n, err := parse(s, complexAlgebra)
+	if err != nil {
+		return nil, err
+	}
+	return n(vars)
+}
+
+// EvalHamilton parses s and evaluates it in dual.Hamilton values, with the
+// variables in vars. Its errors are those of Eval.
+func EvalHamilton(s string, vars map[string]*dual.Hamilton) (*dual.Hamilton, error) {
+	n, err := parse(s, hamiltonAlgebra)
+	if err != nil {
+		return nil, err
+	}
+	return n(vars)
+}
+
+// A parser reads an expression in the values of alg from src by recursive
+// descent.
+type parser[T any, P number[T]] struct {
+	src string
+	pos int
+	alg *algebra[T]
+}
+
+// error returns the error for a failure to parse p.src at the current
+// position, described by msg.
+func (p *parser[T, P]) error(msg string) error {
+	return fmt.Errorf("eval: parsing %q at offset %d: %s", p.src, p.pos, msg)
+}
+
+// unexpected returns the error for the character at the current position.
+func (p *parser[T, P]) unexpected() error {
+	if p.pos >= len(p.src) {
+		return p.error("unexpected end of expression")
+	}
+	r, _ := utf8.DecodeRuneInString(p.src[p.pos:])
+	return p.error("unexpected " + strconv.QuoteRune(r))
+}
+
+// skip moves past any spaces.
+func (p *parser[T, P]) skip() {
+	for p.pos < len(p.src) && p.src[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// accept moves past the operator c and returns true, if it is next.
+func (p *parser[T, P]) accept(c byte) bool {
+	p.skip()
+	if p.pos < len(p.src) && p.src[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// unit moves past the longest unit that is next, and returns the index of its
+// component and true. If no unit is next, then it returns false.
+func (p *parser[T, P]) unit() (int, bool) {
+	u, i := "", 0
+	for w, j := range p.alg.units {
+		if len(w) <= len(u) || !strings.HasPrefix(p.src[p.pos:], w) {
+			continue
+		}
+		r, _ := utf8.DecodeRuneInString(p.src[p.pos+len(w):])
+		if p.pos+len(w) < len(p.src) && isIdent(r) {
+			continue
+		}
+		u, i = w, j
+	}
+	if u == "" {
+		return 0, false
+	}
+	p.pos += len(u)
+	return i, true
+}
+
+// sum parses terms joined by + and -.
+func (p *parser[T, P]) sum() (node[T], error) {
+	x, err := p.product()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var op func(z, x, y *T) *T
+		switch {
+		case p.accept('+'):
+			op = func(z, a, b *T) *T {
+				return P(z).Add(a, b)
+			}
+		case p.accept('-'):
+			op = func(z, a, b *T) *T {
+				return P(z).Sub(a, b)
+			}
+		default:
+			return x, nil
+		}
+		y, err := p.product()
+		if err != nil {
+			return nil, err
+		}
+		x = binary(x, y, func(z, a, b *T) (*T, error) {
+			return op(z, a, b), nil
+		})
+	}
+}
+
+// product parses factors joined by * and /.
+func (p *parser[T, P]) product() (node[T], error) {
+	x, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var op func(z, x, y *T) (*T, error)
+		switch {
+		case p.accept('*'):
+			op = func(z, a, b *T) (*T, error) {
+				return P(z).Mul(a, b), nil
+			}
+		case p.accept('/'):
+			op = func(z, a, b *T) (*T, error) {
+				return P(z).QuoChecked(a, b)
+			}
+		default:
+			return x, nil
+		}
+		y, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		x = binary(x, y, op)
+	}
+}
+
+// binary returns the node that applies op to the values of x and y.
+func binary[T any](x, y node[T], op func(z, a, b *T) (*T, error)) node[T] {
+	return func(vars map[string]*T) (*T, error) {
+		a, err := x(vars)
+		if err != nil {
+			return nil, err
+		}
+		b, err := y(vars)
+		if err != nil {
+			return nil, err
+		}
+		z, err := op(new(T), a, b)
+		if err != nil {
+			return nil, err
+		}
+		return z, nil
+	}
+}
+
+// unary parses a factor with any number of leading signs.
+func (p *parser[T, P]) unary() (node[T], error) {
+	switch {
+	case p.accept('-'):
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return func(vars map[string]*T) (*T, error) {
+			a, err := x(vars)
+			if err != nil {
+				return nil, err
+			}
+			return P(new(T)).Neg(a), nil
+		}, nil
+	case p.accept('+'):
+		return p.unary()
+	}
+	return p.primary()
+}
+
+// primary parses a number, a unit, a variable, a function call, or an
+// expression in parentheses.
+func (p *parser[T, P]) primary() (node[T], error) {
+	p.skip()
+	if p.pos >= len(p.src) {
+		return nil, p.unexpected()
+	}
+	if p.accept('(') {
+		x, err := p.sum()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(')') {
+			return nil, p.error("missing ')'")
+		}
+		return x, nil
+	}
+	if i, ok := p.unit(); ok {
+		return constant[T, P](p.alg.basis(i, 1)), nil
+	}
+	start := p.pos
+	if c := p.src[p.pos]; '0' <= c && c <= '9' || c == '.' {
+		return p.number()
+	}
+	for p.pos < len(p.src) {
+		r, n := utf8.DecodeRuneInString(p.src[p.pos:])
+		if !isIdent(r) || (p.pos == start && unicode.IsDigit(r)) {
+			break
+		}
+		p.pos += n
+	}
+	if p.pos == start {
+		return nil, p.unexpected()
+	}
+	name := p.src[start:p.pos]
+	if f, ok := p.alg.funcs[name]; ok {
+		if !p.accept('(') {
+			return nil, p.error("missing '(' after " + name)
+		}
+		x, err := p.sum()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(')') {
+			return nil, p.error("missing ')'")
+		}
+		return func(vars map[string]*T) (*T, error) {
+			a, err := x(vars)
+			if err != nil {
+				return nil, err
+			}
+			return f(new(T), a), nil
+		}, nil
+	}
+	return func(vars map[string]*T) (*T, error) {
+		v, ok := vars[name]
+		if !ok {
+			return nil, fmt.Errorf("eval: undefined variable %q", name)
+		}
+		return P(new(T)).Copy(v), nil
+	}, nil
+}
+
+// number parses a float64 literal, as a multiple of a unit if the unit follows
+// at once.
+func (p *parser[T, P]) number() (node[T], error) {
+	start := p.pos
+	for p.pos < len(p.src) && (isDigit(p.src[p.pos]) || p.src[p.pos] == '.') {
+		p.pos++
+	}
+	// An exponent must have digits, so that "4eps" reads as 4 and "eps".
+	if p.pos < len(p.src) && (p.src[p.pos] == 'e' || p.src[p.pos] == 'E') {
+		m := p.pos + 1
+		if m < len(p.src) && (p.src[m] == '+' || p.src[m] == '-') {
+			m++
+		}
+		if m < len(p.src) && isDigit(p.src[m]) {
+			for m < len(p.src) && isDigit(p.src[m]) {
+				m++
+			}
+			p.pos = m
+		}
+	}
+	a, err := strconv.ParseFloat(p.src[start:p.pos], 64)
+	if err != nil {
+		p.pos = start
+		return nil, p.error("malformed number")
+	}
+	i, _ := p.unit()
+	return constant[T, P](p.alg.basis(i, a)), nil
+}
+
+// constant returns the node with the value x. Each evaluation returns a new
+// copy of x, so that changing a result does not change the expression.
+func constant[T any, P number[T]](x *T) node[T] {
+	return func(map[string]*T) (*T, error) {
+		return P(new(T)).Copy(x), nil
+	}
+}
+
+// isIdent returns true if r can be part of a variable name.
+func isIdent(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// isDigit returns true if c is a decimal digit.
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
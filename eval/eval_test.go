@@ -0,0 +1,130 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package eval
+
+import (
+	"math"
+	"testing"
+
+	"github.com/meirizarrygelpi/dual"
+)
+
+func TestEval(t *testing.T) {
+	vars := map[string]*dual.Real{
+		"x":     dual.NewReal(2, 1),
+		"theta": dual.NewReal(0.5, 1),
+	}
+	for _, test := range []struct {
+		s    string
+		want *dual.Real
+	}{
+		{"(1+2ε)*(3-ε)/x", dual.NewReal(1.5, 1.75)},
+		{"1 + 2eps", dual.NewReal(1, 2)},
+		{"-x*-x", dual.NewReal(4, 4)},
+		{"2 - 3 - 4", dual.NewReal(-5, 0)},
+		{"8/2/2", dual.NewReal(2, 0)},
+		{"1.5e1 + ε*ε", dual.NewReal(15, 0)},
+		{"sin(theta)", dual.NewReal(math.Sin(0.5), math.Cos(0.5))},
+		{"exp(2*x) - cosh(0)", dual.NewReal(math.Exp(4)-1, 2*math.Exp(4))},
+	} {
+		got, err := Eval(test.s, vars)
+		if err != nil {
+			t.Errorf("Eval(%q) = %v", test.s, err)
+			continue
+		}
+		if !got.EqualsTol(test.want, 1e-12) {
+			t.Errorf("Eval(%q) = %v, want %v", test.s, got, test.want)
+		}
+	}
+}
+
+func TestEvalComplex(t *testing.T) {
+	x := dual.NewComplex(1, 2, 3, 4)
+	vars := map[string]*dual.Complex{"x": x}
+	for _, test := range []struct {
+		s    string
+		want *dual.Complex
+	}{
+		{"i*i", dual.NewComplex(-1, 0, 0, 0)},
+		{"1 + 2i - 3ε + 4epsi", dual.NewComplex(1, 2, -3, 4)},
+		{"εi*εi", dual.NewComplex(0, 0, 0, 0)},
+		{"(1+2i)*(3-εi)", new(dual.Complex).Mul(dual.NewComplex(1, 2, 0, 0), dual.NewComplex(3, 0, 0, -1))},
+		{"x/x", dual.NewComplex(1, 0, 0, 0)},
+		{"sin(x)", new(dual.Complex).Sin(x)},
+	} {
+		got, err := EvalComplex(test.s, vars)
+		if err != nil {
+			t.Errorf("EvalComplex(%q) = %v", test.s, err)
+			continue
+		}
+		if !got.EqualsTol(test.want, 1e-12) {
+			t.Errorf("EvalComplex(%q) = %v, want %v", test.s, got, test.want)
+		}
+	}
+	if _, err := EvalComplex("i/ε", nil); err != dual.ErrZeroDivisor {
+		t.Errorf("EvalComplex(%q) = %v, want ErrZeroDivisor", "i/ε", err)
+	}
+}
+
+func TestEvalHamilton(t *testing.T) {
+	x := dual.NewHamilton(1, 2, 3, 4, 5, 6, 7, 8)
+	vars := map[string]*dual.Hamilton{"x": x}
+	for _, test := range []struct {
+		s    string
+		want *dual.Hamilton
+	}{
+		{"i*j", dual.NewHamilton(0, 0, 0, 1, 0, 0, 0, 0)},
+		{"k*j", dual.NewHamilton(0, -1, 0, 0, 0, 0, 0, 0)},
+		{"1 + 2εi - epsk", dual.NewHamilton(1, 0, 0, 0, 0, 2, 0, -1)},
+		{"εi*εj", dual.NewHamilton(0, 0, 0, 0, 0, 0, 0, 0)},
+		{"(1+εk)*x", new(dual.Hamilton).Mul(dual.NewHamilton(1, 0, 0, 0, 0, 0, 0, 1), x)},
+		{"x/x", dual.NewHamilton(1, 0, 0, 0, 0, 0, 0, 0)},
+	} {
+		got, err := EvalHamilton(test.s, vars)
+		if err != nil {
+			t.Errorf("EvalHamilton(%q) = %v", test.s, err)
+			continue
+		}
+		if !got.EqualsTol(test.want, 1e-12) {
+			t.Errorf("EvalHamilton(%q) = %v, want %v", test.s, got, test.want)
+		}
+	}
+	// dual.Hamilton has no sine, so sin is a variable and the call fails.
+	if _, err := EvalHamilton("sin(x)", vars); err == nil {
+		t.Errorf("EvalHamilton(%q) did not fail", "sin(x)")
+	}
+}
+
+func TestEvalResults(t *testing.T) {
+	// Changing a result changes neither the expression nor the variables.
+	e, err := Parse("2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, _ := e.Eval(nil)
+	r.Add(r, r)
+	if got, _ := e.Eval(nil); !got.Equals(dual.NewReal(2, 0)) {
+		t.Errorf("Eval after changing a result = %v, want (2+0ε)", got)
+	}
+	x := dual.NewReal(3, 1)
+	r, _ = Eval("x", map[string]*dual.Real{"x": x})
+	r.Add(r, r)
+	if !x.Equals(dual.NewReal(3, 1)) {
+		t.Errorf("x after changing a result = %v, want (3+1ε)", x)
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	for _, s := range []string{"", "1 +", "(1", "2 3", "sin 1", "1..2", "x $ 1"} {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("Parse(%q) did not fail", s)
+		}
+	}
+	if z, err := Eval("1/(2ε)", nil); z != nil || err != dual.ErrZeroDivisor {
+		t.Errorf("Eval(%q) = %v, %v, want nil, ErrZeroDivisor", "1/(2ε)", z, err)
+	}
+	if _, err := Eval("y + 1", nil); err == nil {
+		t.Errorf("undefined variable did not fail")
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package exact
+
+import (
+	"math/big"
+
+	"github.com/meirizarrygelpi/dual"
+)
+
+// A Complex represents an exact dual complex number a + bi + cε + dεi as an
+// ordered array of four pointers to big.Float values.
+type Complex [4]*big.Float
+
+// NewComplex returns a pointer to a Complex value made from four given float64
+// values.
+func NewComplex(a, b, c, d float64) *Complex {
+	z := new(Complex)
+	copy(z[:], floats(4, a, b, c, d))
+	return z
+}
+
+// ComplexFrom returns a pointer to the exact Complex value equal to x.
+func ComplexFrom(x *dual.Complex) *Complex {
+	return NewComplex(x.Cartesian())
+}
+
+// Float64 returns the dual.Complex value nearest to z.
+func (z *Complex) Float64() *dual.Complex {
+	v := float64s(z[:])
+	return dual.NewComplex(v[0], v[1], v[2], v[3])
+}
+
+// ULPs returns the largest distance, in units in the last place, between a
+// component of x and the matching component of z.
+func (z *Complex) ULPs(x *dual.Complex) float64 {
+	a, b, c, d := x.Cartesian()
+	return maxULPs([]float64{a, b, c, d}, z[:])
+}
+
+// String returns the string version of z, as that of the nearest dual.Complex.
+func (z *Complex) String() string {
+	return z.Float64().String()
+}
+
+// Add sets z equal to the sum of x and y, and returns z.
+func (z *Complex) Add(x, y *Complex) *Complex {
+	copy(z[:], add(x[:], y[:]))
+	return z
+}
+
+// Sub sets z equal to the difference of x and y, and returns z.
+func (z *Complex) Sub(x, y *Complex) *Complex {
+	copy(z[:], sub(x[:], y[:]))
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Complex) Neg(y *Complex) *Complex {
+	copy(z[:], neg(y[:]))
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z. With x = p + qε
+// and y = r + sε, for complex p, q, r, and s:
+// 		(p + qε)(r + sε) = pr + (ps + qr*)ε
+func (z *Complex) Mul(x, y *Complex) *Complex {
+	p, q := x[0:2], x[2:4]
+	r, s := y[0:2], y[2:4]
+	t := add(mulComplex(p, s), mulComplex(q, conjQuat(r)))
+	copy(z[:], append(mulComplex(p, r), t...))
+	return z
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If the real part of y
+// is zero, then Inv panics.
+func (z *Complex) Inv(y *Complex) *Complex {
+	return z.Quo(NewComplex(1, 0, 0, 0), y)
+}
+
+// Quo sets z equal to the quotient of x and y, that is, x times the inverse of
+// y, and returns z. If the real part of y is zero, then Quo panics:
+// 		(p + qε)/(r + sε) = p/r + ((q - (p/r)s)/r*)ε
+func (z *Complex) Quo(x, y *Complex) *Complex {
+	p, q := x[0:2], x[2:4]
+	r, s := y[0:2], y[2:4]
+	if r[0].Sign() == 0 && r[1].Sign() == 0 {
+		panic("zero divisor denominator")
+	}
+	u := mulComplex(p, invQuat(r))
+	t := mulComplex(sub(q, mulComplex(u, s)), invQuat(conjQuat(r)))
+	copy(z[:], append(u, t...))
+	return z
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+// Package exact implements the arithmetic of package dual over big.Float
+// values. It is slow, but with a high enough precision it serves as a ground
+// truth against which the float64 results of package dual can be checked.
+//
+// Each type mirrors the dual type of the same name, with every component held
+// as a *big.Float of precision Prec. As in package dual, the arithmetic methods
+// have the form z.Op(x, y), set z to the result, and return z, and the result
+// z may be one of the operands.
+//
+// The components of a result are always new *big.Float values, so the zero
+// value of each type can be used as a destination, and the components of an
+// operand are never modified. The ULPs method of each type measures how far a
+// dual value is from the exact one, in units in the last place of float64.
+package exact
+
+import (
+	"math"
+	"math/big"
+)
+
+// Prec is the precision, in bits, of the components made by this package.
+var Prec uint = 512
+
+// newFloat returns a new zero *big.Float with precision Prec.
+func newFloat() *big.Float {
+	return new(big.Float).SetPrec(Prec)
+}
+
+// floats returns n new *big.Float values with precision Prec, set to the
+// float64 values v. The missing values are zero.
+func floats(n int, v ...float64) []*big.Float {
+	f := make([]*big.Float, n)
+	for i := range f {
+		f[i] = newFloat()
+		if i < len(v) {
+			f[i].SetFloat64(v[i])
+		}
+	}
+	return f
+}
+
+// float64s returns the float64 values nearest to the components v.
+func float64s(v []*big.Float) []float64 {
+	f := make([]float64, len(v))
+	for i := range v {
+		f[i], _ = v[i].Float64()
+	}
+	return f
+}
+
+// ULPs returns the distance from got to want in units in the last place of the
+// float64 value nearest to want. It is 0 if got is the correctly rounded value
+// of want, and +Inf if got is NaN or an infinity that want is not.
+func ULPs(got float64, want *big.Float) float64 {
+	w, _ := want.Float64()
+	switch {
+	case math.IsNaN(got):
+		return math.Inf(1)
+	case math.IsInf(got, 0) || math.IsInf(w, 0):
+		if got == w {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	d := newFloat().Sub(newFloat().SetFloat64(got), want)
+	d.Abs(d)
+	a := math.Abs(w)
+	u := math.Nextafter(a, math.Inf(1)) - a
+	e, _ := d.Quo(d, newFloat().SetFloat64(u)).Float64()
+	return e
+}
+
+// maxULPs returns the largest ULPs distance between the matching elements of
+// got and want.
+func maxULPs(got []float64, want []*big.Float) float64 {
+	m := 0.0
+	for i := range got {
+		m = math.Max(m, ULPs(got[i], want[i]))
+	}
+	return m
+}
+
+// add returns the sums of the matching elements of x and y.
+func add(x, y []*big.Float) []*big.Float {
+	z := floats(len(x))
+	for i := range z {
+		z[i].Add(x[i], y[i])
+	}
+	return z
+}
+
+// sub returns the differences of the matching elements of x and y.
+func sub(x, y []*big.Float) []*big.Float {
+	z := floats(len(x))
+	for i := range z {
+		z[i].Sub(x[i], y[i])
+	}
+	return z
+}
+
+// neg returns the negatives of the elements of x.
+func neg(x []*big.Float) []*big.Float {
+	z := floats(len(x))
+	for i := range z {
+		z[i].Neg(x[i])
+	}
+	return z
+}
+
+// mulComplex returns the product of the complex numbers x and y, each given by
+// its two components.
+func mulComplex(x, y []*big.Float) []*big.Float {
+	return mulQuat(pad(x), pad(y))[:2]
+}
+
+// pad returns the complex number x as a quaternion with zero j and k
+// components.
+func pad(x []*big.Float) []*big.Float {
+	return append(x[:2:2], newFloat(), newFloat())
+}
+
+// mulQuat returns the product of the quaternions x and y, each given by its
+// four components in the order 1, i, j, k. A complex number is the special
+// case of zero j and k components.
+func mulQuat(x, y []*big.Float) []*big.Float {
+	a, b, c, d := x[0], x[1], x[2], x[3]
+	e, f, g, h := y[0], y[1], y[2], y[3]
+	return []*big.Float{
+		sum(prod(a, e), neg1(prod(b, f)), neg1(prod(c, g)), neg1(prod(d, h))),
+		sum(prod(a, f), prod(b, e), prod(c, h), neg1(prod(d, g))),
+		sum(prod(a, g), neg1(prod(b, h)), prod(c, e), prod(d, f)),
+		sum(prod(a, h), prod(b, g), neg1(prod(c, f)), prod(d, e)),
+	}
+}
+
+// conjQuat returns the conjugate of the quaternion x. With two components, x
+// is a complex number.
+func conjQuat(x []*big.Float) []*big.Float {
+	z := floats(len(x))
+	z[0].Set(x[0])
+	for i := 1; i < len(x); i++ {
+		z[i].Neg(x[i])
+	}
+	return z
+}
+
+// invQuat returns the inverse of the nonzero quaternion x. With two
+// components, x is a complex number.
+func invQuat(x []*big.Float) []*big.Float {
+	q := newFloat()
+	for _, a := range x {
+		q.Add(q, prod(a, a))
+	}
+	z := conjQuat(x)
+	for i := range z {
+		z[i].Quo(z[i], q)
+	}
+	return z
+}
+
+// prod returns the product of x and y.
+func prod(x, y *big.Float) *big.Float {
+	return newFloat().Mul(x, y)
+}
+
+// neg1 returns the negative of x.
+func neg1(x *big.Float) *big.Float {
+	return newFloat().Neg(x)
+}
+
+// sum returns the sum of the values x.
+func sum(x ...*big.Float) *big.Float {
+	s := newFloat()
+	for _, a := range x {
+		s.Add(s, a)
+	}
+	return s
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package exact
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/meirizarrygelpi/dual"
+)
+
+// tol is the largest normErr accepted between a dual result and the exact
+// one.
+const tol = 1e-14
+
+func TestULPs(t *testing.T) {
+	third := newFloat().Quo(newFloat().SetInt64(1), newFloat().SetInt64(3))
+	if got := ULPs(1.0/3, third); got > 0.5 {
+		t.Errorf("ULPs(1/3) = %v, want at most 0.5", got)
+	}
+	if got := ULPs(math.Nextafter(1.0/3, 1), third); got < 0.5 || got > 1.5 {
+		t.Errorf("ULPs(next 1/3) = %v, want about 1", got)
+	}
+	if got := ULPs(math.NaN(), third); !math.IsInf(got, 1) {
+		t.Errorf("ULPs(NaN) = %v, want +Inf", got)
+	}
+	inf := new(big.Float).SetInf(false)
+	if got := ULPs(math.Inf(1), inf); got != 0 {
+		t.Errorf("ULPs(+Inf, +Inf) = %v, want 0", got)
+	}
+}
+
+// normErr returns the largest difference between got and the components of
+// want, relative to the largest component of want. The dual part of a product
+// is a sum, so a component can lose most of its bits to cancellation while the
+// product as a whole is accurate, and a bound in ULPs would be too strict.
+func normErr(got []float64, want []*big.Float) float64 {
+	w := float64s(want)
+	d, m := 0.0, 0.0
+	for i := range got {
+		d = math.Max(d, math.Abs(got[i]-w[i]))
+		m = math.Max(m, math.Abs(w[i]))
+	}
+	return d / m
+}
+
+// realErr returns the normErr of x against z.
+func realErr(z *Real, x *dual.Real) float64 {
+	a, b := x.Cartesian()
+	return normErr([]float64{a, b}, z[:])
+}
+
+// complexErr returns the normErr of x against z.
+func complexErr(z *Complex, x *dual.Complex) float64 {
+	a, b, c, d := x.Cartesian()
+	return normErr([]float64{a, b, c, d}, z[:])
+}
+
+// hamiltonErr returns the normErr of x against z.
+func hamiltonErr(z *Hamilton, x *dual.Hamilton) float64 {
+	v := make([]float64, 8)
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = x.Cartesian()
+	return normErr(v, z[:])
+}
+
+func TestReal(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		x := dual.NewReal(r.NormFloat64(), r.NormFloat64())
+		y := dual.NewReal(r.NormFloat64(), r.NormFloat64())
+		ex, ey := RealFrom(x), RealFrom(y)
+		if e := realErr(new(Real).Mul(ex, ey), new(dual.Real).Mul(x, y)); e > tol {
+			t.Errorf("Mul(%v, %v) has relative error %v", x, y, e)
+		}
+		if e := realErr(new(Real).Quo(ex, ey), new(dual.Real).Quo(x, y)); e > tol {
+			t.Errorf("Quo(%v, %v) has relative error %v", x, y, e)
+		}
+		if e := realErr(new(Real).Inv(ey), new(dual.Real).Inv(y)); e > tol {
+			t.Errorf("Inv(%v) has relative error %v", y, e)
+		}
+	}
+}
+
+func TestComplex(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		x := dual.NewComplex(r.NormFloat64(), r.NormFloat64(), r.NormFloat64(), r.NormFloat64())
+		y := dual.NewComplex(r.NormFloat64(), r.NormFloat64(), r.NormFloat64(), r.NormFloat64())
+		ex, ey := ComplexFrom(x), ComplexFrom(y)
+		if e := complexErr(new(Complex).Mul(ex, ey), new(dual.Complex).Mul(x, y)); e > tol {
+			t.Errorf("Mul(%v, %v) has relative error %v", x, y, e)
+		}
+		if e := complexErr(new(Complex).Quo(ex, ey), new(dual.Complex).Quo(x, y)); e > tol {
+			t.Errorf("Quo(%v, %v) has relative error %v", x, y, e)
+		}
+		if e := complexErr(new(Complex).Inv(ey), new(dual.Complex).Inv(y)); e > tol {
+			t.Errorf("Inv(%v) has relative error %v", y, e)
+		}
+	}
+}
+
+func TestHamilton(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	v := make([]float64, 16)
+	for i := 0; i < 1000; i++ {
+		for j := range v {
+			v[j] = r.NormFloat64()
+		}
+		x := dual.NewHamilton(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+		y := dual.NewHamilton(v[8], v[9], v[10], v[11], v[12], v[13], v[14], v[15])
+		ex, ey := HamiltonFrom(x), HamiltonFrom(y)
+		if e := hamiltonErr(new(Hamilton).Mul(ex, ey), new(dual.Hamilton).Mul(x, y)); e > tol {
+			t.Errorf("Mul(%v, %v) has relative error %v", x, y, e)
+		}
+		if e := hamiltonErr(new(Hamilton).Quo(ex, ey), new(dual.Hamilton).Quo(x, y)); e > tol {
+			t.Errorf("Quo(%v, %v) has relative error %v", x, y, e)
+		}
+		if e := hamiltonErr(new(Hamilton).Inv(ey), new(dual.Hamilton).Inv(y)); e > tol {
+			t.Errorf("Inv(%v) has relative error %v", y, e)
+		}
+	}
+	x := NewHamilton(1, 2, 3, 4, 0, 0, 0, 0)
+	if e := x.ULPs(x.Float64()); e != 0 {
+		t.Errorf("ULPs of an exact value = %v, want 0", e)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	x := dual.NewHamilton(1, -2, 3, -4, 5, -6, 7, -8)
+	if got := HamiltonFrom(x).Float64(); !got.Equals(x) {
+		t.Errorf("HamiltonFrom(%v).Float64() = %v", x, got)
+	}
+	z := NewReal(3, 1)
+	if got := z.Add(z, z).Float64(); !got.Equals(dual.NewReal(6, 2)) {
+		t.Errorf("Add = %v, want (6+2ε)", got)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package exact
+
+import (
+	"math/big"
+
+	"github.com/meirizarrygelpi/dual"
+)
+
+// A Hamilton represents an exact dual Hamilton quaternion as an ordered array
+// of eight pointers to big.Float values, in the order of dual.Hamilton
+// Cartesian components.
+type Hamilton [8]*big.Float
+
+// NewHamilton returns a pointer to a Hamilton value made from eight given
+// float64 values.
+func NewHamilton(a, b, c, d, e, f, g, h float64) *Hamilton {
+	z := new(Hamilton)
+	copy(z[:], floats(8, a, b, c, d, e, f, g, h))
+	return z
+}
+
+// HamiltonFrom returns a pointer to the exact Hamilton value equal to x.
+func HamiltonFrom(x *dual.Hamilton) *Hamilton {
+	return NewHamilton(x.Cartesian())
+}
+
+// Float64 returns the dual.Hamilton value nearest to z.
+func (z *Hamilton) Float64() *dual.Hamilton {
+	v := float64s(z[:])
+	return dual.NewHamilton(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+}
+
+// ULPs returns the largest distance, in units in the last place, between a
+// component of x and the matching component of z.
+func (z *Hamilton) ULPs(x *dual.Hamilton) float64 {
+	v := make([]float64, 8)
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = x.Cartesian()
+	return maxULPs(v, z[:])
+}
+
+// String returns the string version of z, as that of the nearest
+// dual.Hamilton.
+func (z *Hamilton) String() string {
+	return z.Float64().String()
+}
+
+// Add sets z equal to the sum of x and y, and returns z.
+func (z *Hamilton) Add(x, y *Hamilton) *Hamilton {
+	copy(z[:], add(x[:], y[:]))
+	return z
+}
+
+// Sub sets z equal to the difference of x and y, and returns z.
+func (z *Hamilton) Sub(x, y *Hamilton) *Hamilton {
+	copy(z[:], sub(x[:], y[:]))
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Hamilton) Neg(y *Hamilton) *Hamilton {
+	copy(z[:], neg(y[:]))
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z. With x = p + qε
+// and y = r + sε, for quaternions p, q, r, and s:
+// 		(p + qε)(r + sε) = pr + (sp + qr*)ε
+func (z *Hamilton) Mul(x, y *Hamilton) *Hamilton {
+	p, q := x[0:4], x[4:8]
+	r, s := y[0:4], y[4:8]
+	t := add(mulQuat(s, p), mulQuat(q, conjQuat(r)))
+	copy(z[:], append(mulQuat(p, r), t...))
+	return z
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If the real part of y
+// is zero, then Inv panics:
+// 		(r + sε)⁻¹ = r⁻¹ - (s(r⁻¹)*)r⁻¹ε
+func (z *Hamilton) Inv(y *Hamilton) *Hamilton {
+	r, s := y[0:4], y[4:8]
+	if r[0].Sign() == 0 && r[1].Sign() == 0 && r[2].Sign() == 0 && r[3].Sign() == 0 {
+		panic("zero divisor")
+	}
+	u := invQuat(r)
+	t := neg(mulQuat(mulQuat(s, conjQuat(u)), u))
+	copy(z[:], append(u, t...))
+	return z
+}
+
+// Quo sets z equal to the quotient of x and y, that is, x times the inverse of
+// y, and returns z. If the real part of y is zero, then Quo panics.
+func (z *Hamilton) Quo(x, y *Hamilton) *Hamilton {
+	return z.Mul(x, new(Hamilton).Inv(y))
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package exact
+
+import (
+	"math/big"
+
+	"github.com/meirizarrygelpi/dual"
+)
+
+// A Real represents an exact dual number a + bε as an ordered array of two
+// pointers to big.Float values.
+type Real [2]*big.Float
+
+// NewReal returns a pointer to a Real value made from two given float64
+// values.
+func NewReal(a, b float64) *Real {
+	z := new(Real)
+	copy(z[:], floats(2, a, b))
+	return z
+}
+
+// RealFrom returns a pointer to the exact Real value equal to x.
+func RealFrom(x *dual.Real) *Real {
+	return NewReal(x.Cartesian())
+}
+
+// Float64 returns the dual.Real value nearest to z.
+func (z *Real) Float64() *dual.Real {
+	v := float64s(z[:])
+	return dual.NewReal(v[0], v[1])
+}
+
+// ULPs returns the largest distance, in units in the last place, between a
+// component of x and the matching component of z.
+func (z *Real) ULPs(x *dual.Real) float64 {
+	a, b := x.Cartesian()
+	return maxULPs([]float64{a, b}, z[:])
+}
+
+// String returns the string version of z, as that of the nearest dual.Real.
+func (z *Real) String() string {
+	return z.Float64().String()
+}
+
+// Add sets z equal to the sum of x and y, and returns z.
+func (z *Real) Add(x, y *Real) *Real {
+	copy(z[:], add(x[:], y[:]))
+	return z
+}
+
+// Sub sets z equal to the difference of x and y, and returns z.
+func (z *Real) Sub(x, y *Real) *Real {
+	copy(z[:], sub(x[:], y[:]))
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Real) Neg(y *Real) *Real {
+	copy(z[:], neg(y[:]))
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *Real) Conj(y *Real) *Real {
+	z[0], z[1] = newFloat().Set(y[0]), neg1(y[1])
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z:
+// 		(a + bε)(c + dε) = ac + (ad + bc)ε
+func (z *Real) Mul(x, y *Real) *Real {
+	a, b := x[0], x[1]
+	c, d := y[0], y[1]
+	z[0], z[1] = prod(a, c), sum(prod(a, d), prod(b, c))
+	return z
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If the real part of y
+// is zero, then Inv panics.
+func (z *Real) Inv(y *Real) *Real {
+	return z.Quo(NewReal(1, 0), y)
+}
+
+// Quo sets z equal to the quotient of x and y, and returns z. If the real part
+// of y is zero, then Quo panics:
+// 		(a + bε)/(c + dε) = a/c + ((bc - ad)/c²)ε
+func (z *Real) Quo(x, y *Real) *Real {
+	a, b := x[0], x[1]
+	c, d := y[0], y[1]
+	if c.Sign() == 0 {
+		panic("zero divisor denominator")
+	}
+	e := sum(prod(b, c), neg1(prod(a, d)))
+	z[0], z[1] = newFloat().Quo(a, c), e.Quo(e, prod(c, c))
+	return z
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "math"
+
+// expmDegree is the degree of the Padé approximant used by Expm. With the norm
+// scaled to at most ½, the [6/6] approximant is accurate to about the unit
+// roundoff.
+const expmDegree = 6
+
+// Expm sets z equal to the matrix exponential of y, and returns z. If
+// y = A + εB, then the real part is exp(A) and the dual part is the derivative
+// of exp(A + tB) at t = 0, the Fréchet derivative of the exponential at A along
+// B. If y is not square, then Expm panics.
+//
+// The exponential is computed by scaling and squaring with a diagonal Padé
+// approximant: y is scaled by 2⁻ˢ so that the 1-norm of its real part is at
+// most ½, the approximant
+// 		exp(X) ≈ D(X)⁻¹N(X)
+// is evaluated in dual arithmetic, and the result is squared s times.
+func (z *DualMatrix) Expm(y *DualMatrix) *DualMatrix {
+	if y.rows != y.cols {
+		panic("non-square matrix")
+	}
+	n := y.rows
+	s := 0
+	if norm := y.norm1(); norm > 0.5 {
+		_, e := math.Frexp(norm / 0.5)
+		s = e
+	}
+	x := new(DualMatrix).Scale(y, NewReal(math.Ldexp(1, -s), 0))
+	num, den := NewDualIdentity(n), NewDualIdentity(n)
+	p := NewDualIdentity(n)
+	t := new(DualMatrix)
+	c := 1.0
+	for k := 1; k <= expmDegree; k++ {
+		c *= float64(expmDegree-k+1) / float64(k*(2*expmDegree-k+1))
+		p.Mul(p, x)
+		t.Scale(p, NewReal(c, 0))
+		num.Add(num, t)
+		if k%2 == 1 {
+			den.Sub(den, t)
+		} else {
+			den.Add(den, t)
+		}
+	}
+	// The denominator of the [q/q] approximant is nonsingular for a norm of at
+	// most ½, so Inv does not panic.
+	p.Mul(den.Inv(den), num)
+	for ; s > 0; s-- {
+		p.Mul(p, p)
+	}
+	return z.Copy(p)
+}
+
+// norm1 returns the 1-norm of the real part of z, its largest absolute column
+// sum.
+func (z *DualMatrix) norm1() float64 {
+	var m float64
+	for j := 0; j < z.cols; j++ {
+		var s float64
+		for i := 0; i < z.rows; i++ {
+			s += math.Abs(z.data[i*z.cols+j].Real())
+		}
+		m = math.Max(m, s)
+	}
+	return m
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"testing"
+)
+
+func TestExpm(t *testing.T) {
+	const tol = 1e-12
+	// A rotation generator with a dual angle θ + ε.
+	θ := 2.5
+	a := NewDualMatrix(2, 2, []Real{{0, 0}, {-θ, -1}, {θ, 1}, {0, 0}})
+	s, c := math.Sincos(θ)
+	want := NewDualMatrix(2, 2, []Real{{c, -s}, {-s, -c}, {s, c}, {c, -s}})
+	if got := new(DualMatrix).Expm(a); !DualVector(got.data).EqualsTol(want.data, tol) {
+		t.Errorf("Expm = %v, want %v", got, want)
+	}
+	// A diagonal matrix exponentiates elementwise.
+	d := NewDualMatrix(2, 2, []Real{{3, 1}, {0, 0}, {0, 0}, {-7, 2}})
+	want = NewDualMatrix(2, 2, []Real{{math.Exp(3), math.Exp(3)}, {0, 0}, {0, 0}, {math.Exp(-7), 2 * math.Exp(-7)}})
+	if got := new(DualMatrix).Expm(d); !DualVector(got.data).EqualsTol(want.data, 1e-10) {
+		t.Errorf("Expm = %v, want %v", got, want)
+	}
+}
+
+func TestExpmDerivative(t *testing.T) {
+	// For matrices that do not commute, the dual part must match a finite
+	// difference of the exponential.
+	av := []float64{1, 2, 0, -1, 0.5, 3, 2, 0, -2}
+	bv := []float64{0, 1, 0, 0, 0, 0, 1, 0, 0}
+	y := NewDualMatrix(3, 3, nil)
+	for i := range av {
+		y.data[i] = Real{av[i], bv[i]}
+	}
+	e := new(DualMatrix).Expm(y)
+	const h = 1e-5
+	shift := func(k float64) *DualMatrix {
+		m := NewDualMatrix(3, 3, nil)
+		for i := range av {
+			m.data[i] = Real{av[i] + k*bv[i], 0}
+		}
+		return new(DualMatrix).Expm(m)
+	}
+	p, q := shift(h), shift(-h)
+	for i := range e.data {
+		fd := (p.data[i].Real() - q.data[i].Real()) / (2 * h)
+		if math.Abs(fd-e.data[i].Dual()) > 1e-6*math.Max(1, math.Abs(fd)) {
+			t.Errorf("dual part %d = %v, want %v", i, e.data[i].Dual(), fd)
+		}
+	}
+}
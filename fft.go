@@ -0,0 +1,67 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"math/bits"
+	"math/cmplx"
+)
+
+// FFT returns the discrete Fourier transform of x, a new slice of Complex
+// values:
+// 		X[k] = Σ exp(-2πijk/n) x[j]
+// The twiddle factors multiply each value on the left, so the transform acts
+// separately on the real and dual parts: the dual part of X is the transform of
+// the dual part of x. This gives the sensitivity of the spectrum to the
+// perturbation carried by the ε channel. If the length of x is not a power of
+// two, then FFT panics.
+func FFT(x []Complex) []Complex {
+	return fft(x, -1)
+}
+
+// IFFT returns the inverse discrete Fourier transform of x, a new slice of
+// Complex values, so that IFFT(FFT(x)) equals x:
+// 		x[j] = (1/n) Σ exp(2πijk/n) X[k]
+// If the length of x is not a power of two, then IFFT panics.
+func IFFT(x []Complex) []Complex {
+	y := fft(x, +1)
+	s := 1 / float64(len(y))
+	for i := range y {
+		y[i].Dil(&y[i], s)
+	}
+	return y
+}
+
+// fft returns the radix-2 decimation-in-time transform of x, with the sign of
+// the exponent of the twiddle factors given by sign.
+func fft(x []Complex, sign float64) []Complex {
+	n := len(x)
+	if n == 0 || n&(n-1) != 0 {
+		panic("length not a power of two")
+	}
+	y := make([]Complex, n)
+	shift := uint(64 - bits.TrailingZeros(uint(n)))
+	for i := range x {
+		y[bits.Reverse64(uint64(i))>>shift] = x[i]
+	}
+	w, t := new(Complex), new(Complex)
+	for m := 2; m <= n; m *= 2 {
+		for k := 0; k < m/2; k++ {
+			c := twiddle(sign, k, m)
+			w.Set(real(c), imag(c), 0, 0)
+			for j := k; j < n; j += m {
+				t.Mul(w, &y[j+m/2])
+				y[j+m/2].Sub(&y[j], t)
+				y[j].Add(&y[j], t)
+			}
+		}
+	}
+	return y
+}
+
+// twiddle returns exp(2πi sign k/m).
+func twiddle(sign float64, k, m int) complex128 {
+	return cmplx.Rect(1, sign*2*math.Pi*float64(k)/float64(m))
+}
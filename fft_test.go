@@ -0,0 +1,37 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestFFT(t *testing.T) {
+	x := []Complex{
+		{1, 0.5i}, {2 + 1i, 0}, {0, 1}, {-1, 2 - 1i},
+		{3, 0}, {0.5i, -1}, {1, 1i}, {-2, 0.25},
+	}
+	n := len(x)
+	y := FFT(x)
+	for k := range y {
+		// Direct sums over each channel.
+		var a, b complex128
+		for j := range x {
+			w := cmplx.Rect(1, -2*math.Pi*float64(j*k)/float64(n))
+			a += w * x[j][0]
+			b += w * x[j][1]
+		}
+		if want := (Complex{a, b}); !y[k].EqualsTol(&want, 1e-12) {
+			t.Errorf("FFT[%d] = %v, want %v", k, &y[k], &want)
+		}
+	}
+	z := IFFT(y)
+	for i := range x {
+		if !z[i].EqualsTol(&x[i], 1e-12) {
+			t.Errorf("IFFT(FFT)[%d] = %v, want %v", i, &z[i], &x[i])
+		}
+	}
+}
@@ -0,0 +1,366 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ASCII controls whether String and Format print the basis symbols in ASCII,
+// such as "eps", "epsi", "s1", and "u1", in place of the Unicode symbols, such
+// as ε, εi, σ, and υ₁. This is useful for logs, terminals, and CSV consumers
+// that mangle Unicode. The ASCII symbols are accepted by the Parse functions.
+//
+// ASCII is read without synchronization, so it must be set before any value is
+// formatted, for example in main or an init function, and not changed while
+// other goroutines format values. To choose the symbols per call, use
+// FormatWith with the Symbols option instead.
+var ASCII = false
+
+// symbols returns the basis symbols to print, either the Unicode symbols u or
+// the ASCII symbols a, depending on the value of ASCII.
+func symbols(u, a []string) []string {
+	if ASCII {
+		return a
+	}
+	return u
+}
+
+// A stringer is a value with both a String and a GoString method, as are all
+// the number types of this package.
+type stringer interface {
+	fmt.Stringer
+	fmt.GoStringer
+}
+
+// formatComponents writes the components v of x, with basis symbols symb, to
+// s as "(v₀+v₁symb₁+...)" under the control of verb and the flags, width, and
+// precision of s. The floating-point verbs 'e', 'E', 'f', 'F', 'g', and 'G'
+// apply to each component separately, as do the width and precision; 'v' and
+// 's' behave like 'g'. A sign is always printed for all but the first
+// component, and for the first one only with the '+' flag. The verb "%#v"
+// prints the GoString of x, and other verbs are reported as bad verbs in the
+// style of the fmt package.
+func formatComponents(s fmt.State, verb rune, v []float64, symb []string, x stringer) {
+	switch verb {
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+	case 'v':
+		if s.Flag('#') {
+			io.WriteString(s, x.GoString())
+			return
+		}
+		verb = 'g'
+	case 's':
+		verb = 'g'
+	default:
+		fmt.Fprintf(s, "%%!%c(%T=%s)", verb, x, x.String())
+		return
+	}
+	first := componentFormat(s, verb, false)
+	rest := componentFormat(s, verb, true)
+	io.WriteString(s, "(")
+	fmt.Fprintf(s, first, v[0])
+	for i := 1; i < len(v); i++ {
+		fmt.Fprintf(s, rest, v[i])
+		io.WriteString(s, symb[i])
+	}
+	io.WriteString(s, ")")
+}
+
+// appendComponents appends the components v, with basis symbols symb, to dst
+// as "(v₀+v₁symb₁+...)" in the %g format, and returns the extended buffer. A
+// sign is always written for all but the first component.
+func appendComponents(dst []byte, v []float64, symb []string) []byte {
+	dst = append(dst, '(')
+	dst = strconv.AppendFloat(dst, v[0], 'g', -1, 64)
+	for i := 1; i < len(v); i++ {
+		if !math.Signbit(v[i]) && !math.IsInf(v[i], +1) {
+			dst = append(dst, '+')
+		}
+		dst = strconv.AppendFloat(dst, v[i], 'g', -1, 64)
+		dst = append(dst, symb[i]...)
+	}
+	return append(dst, ')')
+}
+
+// componentFormat returns the format string for a single float64 component,
+// made from verb and the flags, width, and precision of s. If sign is true,
+// then the '+' flag is always included.
+func componentFormat(s fmt.State, verb rune, sign bool) string {
+	f := []byte{'%'}
+	if sign || s.Flag('+') {
+		f = append(f, '+')
+	}
+	for _, c := range "-# 0" {
+		if s.Flag(int(c)) {
+			f = append(f, byte(c))
+		}
+	}
+	if w, ok := s.Width(); ok {
+		f = strconv.AppendInt(f, int64(w), 10)
+	}
+	if p, ok := s.Precision(); ok {
+		f = append(f, '.')
+		f = strconv.AppendInt(f, int64(p), 10)
+	}
+	return string(append(f, byte(verb)))
+}
+
+// A SymbolSet selects the basis symbols used by FormatWith.
+type SymbolSet int
+
+const (
+	// DefaultSymbols are the symbols used by String, as selected by ASCII.
+	DefaultSymbols SymbolSet = iota
+	// UnicodeSymbols are the Unicode symbols, such as ε and υ₁.
+	UnicodeSymbols
+	// ASCIISymbols are the ASCII symbols, such as "eps" and "u1".
+	ASCIISymbols
+	// LatexSymbols are the LaTeX symbols, such as \varepsilon and \upsilon_{1}.
+	LatexSymbols
+)
+
+// FormatOptions holds the options for the FormatWith method of each type. The
+// zero value prints the same string as String.
+type FormatOptions struct {
+	// Verb is the strconv.FormatFloat format of each component: 'e', 'E',
+	// 'f', 'g', or 'G'. If zero, then 'g' is used.
+	Verb byte
+
+	// Precision points to the strconv.FormatFloat precision of each
+	// component, so that a precision of zero can be told apart from no
+	// precision. If nil or negative, then the smallest number of digits
+	// necessary to represent each component exactly is used.
+	Precision *int
+
+	// OmitZero omits the terms with a zero coefficient. If every coefficient
+	// is zero, then a single "0" is printed.
+	OmitZero bool
+
+	// Separator is written on both sides of the sign between terms. For
+	// example, " " gives "(1 + 2ε)".
+	Separator string
+
+	// Symbols selects the basis symbols.
+	Symbols SymbolSet
+
+	// Basis overrides the basis symbols selected by Symbols. It holds one
+	// symbol per component, in the order used by String, starting with the
+	// symbol of the real component (normally empty). Only the first len(Basis)
+	// symbols are overridden, so that, for example, []string{"", "e"} prints a
+	// Real value as "(3+4e)".
+	Basis []string
+
+	// NoParens omits the enclosing parentheses.
+	NoParens bool
+
+	// NoSignedZero prints a negative zero component as a positive zero. By
+	// default, as with String, the sign of a zero is printed, so that the
+	// conjugate of (1+0ε) prints as (1-0ε).
+	NoSignedZero bool
+
+	// Exponential prints Real values in the exponential form r·exp(tε), and
+	// unit Hamilton values in the screw form exp(½(θ+dε)(u+εm)) of the rigid
+	// motion they encode, with θ the rotation angle and d the translation
+	// along the screw axis of direction u and moment m. Values with no such
+	// form, like zero divisors, are printed as usual. Other types ignore
+	// Exponential.
+	Exponential bool
+}
+
+// exponentialWords holds the product sign, the exponential function, and the
+// factor one half used by the exponential forms in each symbol set.
+var exponentialWords = map[SymbolSet][3]string{
+	UnicodeSymbols: {"·", "exp", "½"},
+	ASCIISymbols:   {"*", "exp", "0.5*"},
+	LatexSymbols:   {" ", `\exp`, `\tfrac{1}{2}`},
+}
+
+// format returns the string version of the components v under the options o,
+// with u, a, and l the Unicode, ASCII, and LaTeX basis symbols.
+func (o *FormatOptions) format(v []float64, u, a, l []string) string {
+	if o == nil {
+		o = new(FormatOptions)
+	}
+	s := o.sum(v, o.basis(u, a, l))
+	if o.NoParens {
+		return s
+	}
+	return "(" + s + ")"
+}
+
+// exponential returns the exponential form r·exp(tε) under the options o,
+// with ε the basis symbol.
+func (o *FormatOptions) exponential(r, t float64, ε string) string {
+	w := exponentialWords[o.set()]
+	s := o.sum([]float64{r}, []string{""}) + w[0] + w[1] +
+		"(" + o.sum([]float64{t}, []string{ε}) + ")"
+	if o.NoParens {
+		return s
+	}
+	return "(" + s + ")"
+}
+
+// screw returns the screw form exp(½(θ+dε)(u+εm)) under the options o, with
+// symb the basis symbols of Hamilton.
+func (o *FormatOptions) screw(θ *DualAngle, u, m [3]float64, symb []string) string {
+	w := exponentialWords[o.set()]
+	v := []float64{u[0], u[1], u[2], m[0], m[1], m[2]}
+	axis := []string{symb[1], symb[2], symb[3], symb[5], symb[6], symb[7]}
+	s := w[1] + "(" + w[2] +
+		"(" + o.sum(θ[:], []string{"", symb[4]}) + ")" +
+		"(" + o.sum(v, axis) + "))"
+	if o.NoParens {
+		return s
+	}
+	return "(" + s + ")"
+}
+
+// set returns the symbol set selected by o, with DefaultSymbols resolved
+// according to ASCII.
+func (o *FormatOptions) set() SymbolSet {
+	if o.Symbols != DefaultSymbols {
+		return o.Symbols
+	}
+	if ASCII {
+		return ASCIISymbols
+	}
+	return UnicodeSymbols
+}
+
+// basis returns the basis symbols selected by o, out of the Unicode, ASCII,
+// and LaTeX symbols u, a, and l, with the overrides of o.Basis applied.
+func (o *FormatOptions) basis(u, a, l []string) []string {
+	symb := u
+	switch o.set() {
+	case ASCIISymbols:
+		symb = a
+	case LatexSymbols:
+		symb = l
+	}
+	if len(o.Basis) == 0 {
+		return symb
+	}
+	symb = append([]string(nil), symb...)
+	copy(symb, o.Basis)
+	return symb
+}
+
+// number returns the absolute value of x formatted under the options o.
+func (o *FormatOptions) number(x float64) string {
+	verb, prec := o.Verb, -1
+	if verb == 0 {
+		verb = 'g'
+	}
+	if o.Precision != nil && *o.Precision >= 0 {
+		prec = *o.Precision
+	}
+	s := strconv.FormatFloat(math.Abs(x), verb, prec, 64)
+	if math.IsInf(x, 0) {
+		s = "Inf"
+	}
+	if o.set() == LatexSymbols {
+		s = latexNumber(s)
+	}
+	return s
+}
+
+// sum returns the components v, with basis symbols symb, as a sum of terms
+// formatted under the options o.
+func (o *FormatOptions) sum(v []float64, symb []string) string {
+	b := make([]string, 0, 4*len(v))
+	n := 0
+	for i, x := range v {
+		if o.OmitZero && x == 0 {
+			continue
+		}
+		neg := math.Signbit(x) && !math.IsNaN(x) && !(o.NoSignedZero && x == 0)
+		switch {
+		case n > 0 && neg:
+			b = append(b, o.Separator, "-", o.Separator)
+		case n > 0:
+			b = append(b, o.Separator, "+", o.Separator)
+		case neg:
+			b = append(b, "-")
+		}
+		b = append(b, o.number(x), symb[i])
+		n++
+	}
+	if n == 0 {
+		b = append(b, "0")
+	}
+	return strings.Join(b, "")
+}
+
+// goCall returns the Go syntax of a call to the function name with the float64
+// arguments v.
+func goCall(name string, v ...float64) string {
+	return name + "(" + goFloats(v) + ")"
+}
+
+// goFloats returns the Go syntax of the float64 values v, separated by commas.
+func goFloats(v []float64) string {
+	a := make([]string, len(v))
+	for i := range v {
+		a[i] = goFloat(v[i])
+	}
+	return strings.Join(a, ", ")
+}
+
+// goFloat returns the Go syntax of the float64 value a.
+func goFloat(a float64) string {
+	switch {
+	case math.IsInf(a, +1):
+		return "math.Inf(1)"
+	case math.IsInf(a, -1):
+		return "math.Inf(-1)"
+	case math.IsNaN(a):
+		return "math.NaN()"
+	case a == 0 && math.Signbit(a):
+		return "math.Copysign(0, -1)"
+	}
+	return strconv.FormatFloat(a, 'g', -1, 64)
+}
+
+// latexComponents returns the LaTeX representation of the components v, with
+// basis symbols symb, as a sum of terms with explicit signs.
+func latexComponents(v []float64, symb []string) string {
+	a := make([]string, 1, (2*len(v))+1)
+	a[0] = latexFloat(v[0])
+	for i := 1; i < len(v); i++ {
+		if math.Signbit(v[i]) {
+			a = append(a, " - ", latexFloat(-v[i])+symb[i])
+		} else {
+			a = append(a, " + ", latexFloat(v[i])+symb[i])
+		}
+	}
+	return strings.Join(a, "")
+}
+
+// latexFloat returns the LaTeX representation of a, with an exponent written
+// as a power of 10.
+func latexFloat(a float64) string {
+	return latexNumber(strconv.FormatFloat(a, 'g', -1, 64))
+}
+
+// latexNumber returns the LaTeX representation of the formatted float64 s,
+// with an exponent written as a power of 10.
+func latexNumber(s string) string {
+	switch strings.TrimLeft(s, "+-") {
+	case "Inf":
+		return strings.TrimPrefix(strings.TrimSuffix(s, "Inf"), "+") + `\infty`
+	case "NaN":
+		return `\mathrm{NaN}`
+	}
+	i := strings.IndexAny(s, "eE")
+	if i < 0 {
+		return s
+	}
+	e, _ := strconv.Atoi(s[i+1:])
+	return s[:i] + ` \times 10^{` + strconv.Itoa(e) + "}"
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+func ExampleReal_Format() {
+	z := NewReal(3.14159, -0.5)
+	fmt.Printf("%v\n", z)
+	fmt.Printf("%.3f\n", z)
+	fmt.Printf("%+.2e\n", z)
+	fmt.Printf("[%8.3f]\n", z)
+	fmt.Printf("%d\n", z)
+	// Output:
+	// (3.14159-0.5ε)
+	// (3.142-0.500ε)
+	// (+3.14e+00-5.00e-01ε)
+	// [(   3.142  -0.500ε)]
+	// %!d(*dual.Real=(3.14159-0.5ε))
+}
+
+func ExampleASCII() {
+	ASCII = true
+	defer func() { ASCII = false }()
+	fmt.Println(NewReal(3, -4))
+	fmt.Println(NewUltra(1, 2, 3, 4, 5, 6, 7, 8))
+	// Output:
+	// (3-4eps)
+	// (1+2u1+3u2+4u3+5u4+6u5+7u6+8u7)
+}
+
+func ExampleReal_Latex() {
+	fmt.Println(NewReal(3, -4).Latex())
+	fmt.Println(NewReal(-1.5e-7, 2e21).Latex())
+	fmt.Println(RealInf(+1, -1).Latex())
+	// Output:
+	// 3 - 4\varepsilon
+	// -1.5 \times 10^{-7} + 2 \times 10^{21}\varepsilon
+	// \infty - \infty\varepsilon
+}
+
+func ExampleReal_FormatWith() {
+	z := NewReal(3.14159, -0.5)
+	two, zero := 2, 0
+	fmt.Println(z.FormatWith(nil))
+	fmt.Println(z.FormatWith(&FormatOptions{Verb: 'f', Precision: &two, Separator: " "}))
+	fmt.Println(z.FormatWith(&FormatOptions{Verb: 'f', Precision: &zero}))
+	fmt.Println(NewReal(0, 2).FormatWith(&FormatOptions{OmitZero: true, NoParens: true}))
+	fmt.Println(NewUltra(1, 0, 0, 0, 0, 0, 0, -2).FormatWith(&FormatOptions{
+		OmitZero: true,
+		Symbols:  ASCIISymbols,
+	}))
+	// Output:
+	// (3.14159-0.5ε)
+	// (3.14 - 0.50ε)
+	// (3-0ε)
+	// 2ε
+	// (1-2u7)
+}
+
+func ExampleFormatOptions_basis() {
+	o := &FormatOptions{Basis: []string{"", "e"}}
+	fmt.Println(NewReal(3, 4).FormatWith(o))
+	o = &FormatOptions{Separator: " ", Basis: []string{"", "dx", "dy", "dxdy"}}
+	fmt.Println(NewHyper(1, 2, 3, 4).FormatWith(o))
+	// Output:
+	// (3+4e)
+	// (1 + 2dx + 3dy + 4dxdy)
+}
+
+func ExampleFormatOptions_exponential() {
+	o := &FormatOptions{Exponential: true}
+	fmt.Println(NewReal(2, 1).FormatWith(o))
+	fmt.Println(NewReal(0, 1).FormatWith(o))
+	// Output:
+	// (2·exp(0.5ε))
+	// (0+1ε)
+}
+
+func ExampleReal_GoString() {
+	fmt.Printf("%#v\n", NewReal(1, -2.5))
+	fmt.Printf("%#v\n", RealInf(+1, -1))
+	fmt.Printf("%#v\n", NewLine([3]float64{0, 0, 1}, [3]float64{1, 0, 1}))
+	// Output:
+	// dual.NewReal(1, -2.5)
+	// dual.NewReal(math.Inf(1), math.Inf(-1))
+	// dual.NewLinePlucker([3]float64{1, 0, 0}, [3]float64{0, 1, 0})
+}
+
+func TestAppendString(t *testing.T) {
+	for _, x := range []interface {
+		fmt.Stringer
+		AppendString([]byte) []byte
+	}{
+		NewReal(1, math.Copysign(0, -1)),
+		NewComplex(1, math.Inf(1), math.NaN(), -2e-30),
+		NewHamilton(1, -2, 3, 4e21, 5, math.Inf(-1), 7, 8),
+		NewPerplex(1, 2, 3, 4),
+		NewSuper(1, 2, 3, 4),
+		NewHyper(1, 2, 3, 4),
+		NewUltra(1, 2, 3, 4, 5, 6, 7, 8),
+	} {
+		if got, want := string(x.AppendString([]byte("x="))), "x="+x.String(); got != want {
+			t.Errorf("AppendString = %q, want %q", got, want)
+		}
+	}
+	if got, want := NewComplex(1, math.Inf(1), math.NaN(), -2e-30).String(), "(1+Infi+NaNε-2e-30εi)"; got != want {
+		t.Errorf("String = %q, want %q", got, want)
+	}
+	z := NewHamilton(1, 2, 3, 4, 5, 6, 7, 8)
+	buf := make([]byte, 0, 64)
+	if n := testing.AllocsPerRun(100, func() { buf = z.AppendString(buf[:0]) }); n != 0 {
+		t.Errorf("AppendString allocates %v times", n)
+	}
+}
+
+func TestSignedZero(t *testing.T) {
+	nz := math.Copysign(0, -1)
+	x := NewReal(0, nz)
+	if got := new(Real).Neg(new(Real).Neg(x)); !got.Equal(x) {
+		t.Errorf("Neg(Neg(%v)) = %v", x, got)
+	}
+	if got := new(Real).Conj(NewReal(1, 0)); !got.Equal(NewReal(1, nz)) {
+		t.Errorf("Conj(1+0ε) = %v, want (1-0ε)", got)
+	}
+	for _, z := range []interface {
+		String() string
+	}{
+		new(Real).Neg(NewReal(0, 0)),
+		new(Complex).Neg(NewComplex(0, 0, 0, 0)),
+		new(Hamilton).Neg(NewHamilton(0, 0, 0, 0, 0, 0, 0, 0)),
+		new(Perplex).Neg(NewPerplex(0, 0, 0, 0)),
+		new(Super).Neg(NewSuper(0, 0, 0, 0)),
+		new(Hyper).Neg(NewHyper(0, 0, 0, 0)),
+		new(Ultra).Neg(NewUltra(0, 0, 0, 0, 0, 0, 0, 0)),
+	} {
+		if s := z.String(); !strings.HasPrefix(s, "(-0") || strings.Contains(s, "+") {
+			t.Errorf("Neg of zero = %s, want every component -0", s)
+		}
+	}
+	h := NewHamilton(1, 0, nz, 2, 0, nz, 3, 0)
+	if got := new(Hamilton).Conj(new(Hamilton).Conj(h)); !got.Equal(h) {
+		t.Errorf("Conj(Conj(%v)) = %v", h, got)
+	}
+	p := NewPerplex(1, 0, nz, 2)
+	if got := new(Perplex).Conj(new(Perplex).Conj(p)); !got.Equal(p) {
+		t.Errorf("Conj(Conj(%v)) = %v", p, got)
+	}
+	o := &FormatOptions{NoSignedZero: true}
+	if got, want := NewReal(nz, nz).FormatWith(o), "(0+0ε)"; got != want {
+		t.Errorf("FormatWith NoSignedZero = %q, want %q", got, want)
+	}
+	if got, want := NewReal(nz, nz).FormatWith(nil), "(-0-0ε)"; got != want {
+		t.Errorf("FormatWith = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+// The functional helpers below call their function with pointers into the
+// slices, so that no element is copied or allocated. Map and Zip write into a
+// dst that the caller provides, and Filter appends to one, so a slice can be
+// filtered in place with Filter(s[:0], s, keep).
+
+// RealMap calls f(&dst[i], &src[i]) for each index i, in order. The function f
+// should set its first argument from its second. If dst and src do not have the
+// same length, then RealMap panics.
+func RealMap(dst, src []Real, f func(z, x *Real)) {
+	checkLen(len(dst), len(src))
+	for i := range dst {
+		f(&dst[i], &src[i])
+	}
+}
+
+// RealZip calls f(&dst[i], &a[i], &b[i]) for each index i, in order. The
+// function f should set its first argument from the other two. If the slices do
+// not have the same length, then RealZip panics.
+func RealZip(dst, a, b []Real, f func(z, x, y *Real)) {
+	checkLen(len(dst), len(a), len(b))
+	for i := range dst {
+		f(&dst[i], &a[i], &b[i])
+	}
+}
+
+// RealReduce calls f(z, &s[i]) for each index i, in order, and returns z.
+// The function f should update the accumulator z from the element; for
+// example, with f = func(z, x *Real) { z.Add(z, x) } and z zero, RealReduce
+// returns the sum of s.
+func RealReduce(s []Real, z *Real, f func(z, x *Real)) *Real {
+	for i := range s {
+		f(z, &s[i])
+	}
+	return z
+}
+
+// RealFilter appends to dst the elements of src for which keep returns true, in
+// order, and returns the extended slice. The slice dst can be src[:0], to
+// filter src in place.
+func RealFilter(dst, src []Real, keep func(x *Real) bool) []Real {
+	for i := range src {
+		if keep(&src[i]) {
+			dst = append(dst, src[i])
+		}
+	}
+	return dst
+}
+
+// HamiltonMap calls f(&dst[i], &src[i]) for each index i, in order. The
+// function f should set its first argument from its second. If dst and src do
+// not have the same length, then HamiltonMap panics.
+func HamiltonMap(dst, src []Hamilton, f func(z, x *Hamilton)) {
+	checkLen(len(dst), len(src))
+	for i := range dst {
+		f(&dst[i], &src[i])
+	}
+}
+
+// HamiltonZip calls f(&dst[i], &a[i], &b[i]) for each index i, in order. The
+// function f should set its first argument from the other two. If the slices do
+// not have the same length, then HamiltonZip panics.
+func HamiltonZip(dst, a, b []Hamilton, f func(z, x, y *Hamilton)) {
+	checkLen(len(dst), len(a), len(b))
+	for i := range dst {
+		f(&dst[i], &a[i], &b[i])
+	}
+}
+
+// HamiltonReduce calls f(z, &s[i]) for each index i, in order, and returns z.
+// The function f should update the accumulator z from the element; for
+// example, with f = func(z, x *Hamilton) { z.Add(z, x) } and z zero,
+// HamiltonReduce returns the sum of s.
+func HamiltonReduce(s []Hamilton, z *Hamilton, f func(z, x *Hamilton)) *Hamilton {
+	for i := range s {
+		f(z, &s[i])
+	}
+	return z
+}
+
+// HamiltonFilter appends to dst the elements of src for which keep returns
+// true, in order, and returns the extended slice. The slice dst can be src[:0],
+// to filter src in place.
+func HamiltonFilter(dst, src []Hamilton, keep func(x *Hamilton) bool) []Hamilton {
+	for i := range src {
+		if keep(&src[i]) {
+			dst = append(dst, src[i])
+		}
+	}
+	return dst
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "testing"
+
+func TestRealFunctional(t *testing.T) {
+	s := []Real{*NewReal(1, 1), *NewReal(-2, 0), *NewReal(3, 2)}
+	sq := make([]Real, len(s))
+	RealMap(sq, s, func(z, x *Real) { z.Mul(x, x) })
+	if want := (DualVector{*NewReal(1, 2), *NewReal(4, 0), *NewReal(9, 12)}); !DualVector(sq).Equals(want) {
+		t.Errorf("squares = %v, want %v", sq, want)
+	}
+	sum := RealReduce(sq, new(Real), func(z, x *Real) { z.Add(z, x) })
+	if want := NewReal(14, 14); !sum.Equals(want) {
+		t.Errorf("sum = %v, want %v", sum, want)
+	}
+	RealZip(sq, sq, s, func(z, x, y *Real) { z.Sub(x, y) })
+	if want := (DualVector{*NewReal(0, 1), *NewReal(6, 0), *NewReal(6, 10)}); !DualVector(sq).Equals(want) {
+		t.Errorf("differences = %v, want %v", sq, want)
+	}
+	pos := RealFilter(s[:0], s, func(x *Real) bool { return x.Real() > 0 })
+	if want := (DualVector{*NewReal(1, 1), *NewReal(3, 2)}); !DualVector(pos).Equals(want) {
+		t.Errorf("filtered = %v, want %v", pos, want)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("RealMap with mismatched lengths did not panic")
+		}
+	}()
+	RealMap(sq, s[:1], func(z, x *Real) {})
+}
+
+func TestHamiltonFunctional(t *testing.T) {
+	s := []Hamilton{*NewHamilton(1, 2, 0, 0, 0, 0, 0, 0), *NewHamilton(0, 0, 3, 0, 0, 0, 0, 1)}
+	c := make([]Hamilton, len(s))
+	HamiltonMap(c, s, func(z, x *Hamilton) { z.Conj(x) })
+	HamiltonZip(c, c, s, func(z, x, y *Hamilton) { z.Add(x, y) })
+	sum := HamiltonReduce(c, new(Hamilton), func(z, x *Hamilton) { z.Add(z, x) })
+	if want := NewHamilton(2, 0, 0, 0, 0, 0, 0, 0); !sum.Equals(want) {
+		t.Errorf("sum = %v, want %v", sum, want)
+	}
+	if got := HamiltonFilter(nil, s, (*Hamilton).IsZeroDiv); len(got) != 0 {
+		t.Errorf("filtered = %v, want none", got)
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+// In the geometry of dual numbers, a Real value t + xε is a point, or event,
+// with time t and position x. Multiplication by a unit dual number
+// 		exp(vε) = 1 + vε
+// maps t + xε to t + (x + vt)ε, which is the Galilean boost with velocity v: a
+// shear that keeps time fixed, just as multiplication by exp(iθ) rotates the
+// complex plane. Boosts compose by adding velocities, and the velocity of the
+// boost taking the direction of one point to that of another plays the role
+// of the angle between them.
+
+// GalileanBoost returns a pointer to the unit Real value exp(vε) = 1 + vε, the
+// Galilean boost with velocity v.
+func GalileanBoost(v float64) *Real {
+	return NewReal(1, v)
+}
+
+// Boost sets z equal to the point y boosted with velocity v, that is,
+// y(1 + vε), and returns z:
+// 		(t + xε)(1 + vε) = t + (x + vt)ε
+func (z *Real) Boost(y *Real, v float64) *Real {
+	t, x := y.Real(), y.Dual()
+	return z.Set(t, x+v*t)
+}
+
+// Velocity returns the velocity x/t of the point z = t + xε, that is, the
+// velocity of the boost that takes the point 1 to the direction of z. It is
+// the dual argument of Polar, and it is infinite or NaN if t is zero.
+func (z *Real) Velocity() float64 {
+	return z.Dual() / z.Real()
+}
+
+// RelativeVelocity returns the velocity of the boost that takes the direction
+// of the point x to that of the point y, the Galilean analog of the angle from
+// x to y. It is infinite or NaN if either point has zero time.
+func RelativeVelocity(x, y *Real) float64 {
+	return y.Velocity() - x.Velocity()
+}
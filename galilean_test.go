@@ -0,0 +1,31 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "testing"
+
+func TestGalilean(t *testing.T) {
+	p := NewReal(2, 3)
+	if got, want := new(Real).Boost(p, 5), NewReal(2, 13); !got.Equals(want) {
+		t.Errorf("Boost = %v, want %v", got, want)
+	}
+	if got, want := new(Real).Boost(p, 5), new(Real).Mul(p, GalileanBoost(5)); !got.Equals(want) {
+		t.Errorf("Boost = %v, want the product %v", got, want)
+	}
+	b := new(Real).Mul(GalileanBoost(1.5), GalileanBoost(-4))
+	if want := GalileanBoost(-2.5); !b.Equals(want) {
+		t.Errorf("composed boost = %v, want %v", b, want)
+	}
+	if got := new(Real).Exp(NewReal(0, 7)); !got.Equals(GalileanBoost(7)) {
+		t.Errorf("exp(7ε) = %v, want %v", got, GalileanBoost(7))
+	}
+	q := NewReal(4, -2)
+	v := RelativeVelocity(p, q)
+	if got := new(Real).Boost(p, v).Velocity(); got != q.Velocity() {
+		t.Errorf("boosted velocity = %v, want %v", got, q.Velocity())
+	}
+	if _, tt := p.Polar(); tt != p.Velocity() {
+		t.Errorf("Velocity = %v, want the dual argument %v", p.Velocity(), tt)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+// Package gonumdual connects the dual numbers of package dual with the
+// numerical packages of gonum.
+//
+// An objective is a function of a vector of dual.Real values. Evaluated at
+// x + εeᵢ, with eᵢ the i-th unit vector, its dual part is the i-th partial
+// derivative, so n evaluations give the exact gradient in forward mode. Grad
+// presents this gradient in the form of the Grad field of an
+// optimize.Problem, Problem builds the whole optimize.Problem for the
+// optimizers of gonum/optimize, and GradError compares the gradient with the
+// finite differences of gonum/diff/fd.
+//
+// QuatParts, FromQuatParts, Number, and FromNumber convert between
+// dual.Hamilton values and the quaternions of gonum/num/quat and dual
+// quaternions of gonum/num/dualquat. The product of dualquat.Mul is the
+// product of dual.Hamilton.Compose.
+package gonumdual
+
+import (
+	"math"
+
+	"github.com/meirizarrygelpi/dual"
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/num/dualquat"
+	"gonum.org/v1/gonum/num/quat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+// An Objective is a scalar function of n dual.Real values, written in dual
+// arithmetic so that the derivatives follow the values.
+type Objective func(x []dual.Real) *dual.Real
+
+// seed returns x as dual.Real values with the dual part 1 at index i and 0
+// elsewhere. A negative i gives zero dual parts.
+func seed(x []float64, i int) []dual.Real {
+	y := make([]dual.Real, len(x))
+	for j := range x {
+		y[j].SetReal(x[j])
+	}
+	if i >= 0 {
+		y[i].SetDual(1)
+	}
+	return y
+}
+
+// Func returns f as a function of float64 values, in the form of the Func
+// field of an optimize.Problem.
+func Func(f Objective) func(x []float64) float64 {
+	return func(x []float64) float64 {
+		return f(seed(x, -1)).Real()
+	}
+}
+
+// Grad returns the gradient of f, in the form of the Grad field of an
+// optimize.Problem: it sets grad to the gradient of f at x, evaluating f once
+// per component of x. If grad does not have the length of x, then the returned
+// function panics.
+func Grad(f Objective) func(grad, x []float64) {
+	return func(grad, x []float64) {
+		if len(grad) != len(x) {
+			panic("gonumdual: mismatched lengths")
+		}
+		for i := range x {
+			grad[i] = f(seed(x, i)).Dual()
+		}
+	}
+}
+
+// Problem returns the optimize.Problem of minimizing f, with the Func and Grad
+// fields from Func and Grad.
+func Problem(f Objective) optimize.Problem {
+	return optimize.Problem{
+		Func: Func(f),
+		Grad: Grad(f),
+	}
+}
+
+// GradError returns the largest absolute difference between the gradient of f
+// at x from Grad and the one estimated by fd.Gradient with the settings s. A nil
+// s uses the defaults of fd.Gradient.
+func GradError(f Objective, x []float64, s *fd.Settings) float64 {
+	g := make([]float64, len(x))
+	Grad(f)(g, x)
+	h := fd.Gradient(nil, Func(f), x, s)
+	var e float64
+	for i := range g {
+		e = math.Max(e, math.Abs(g[i]-h[i]))
+	}
+	return e
+}
+
+// QuatParts returns the real and dual quaternion parts of q as quat.Number
+// values.
+func QuatParts(q *dual.Hamilton) (r, d quat.Number) {
+	a, b, c, e, f, g, h, k := q.Cartesian()
+	return quat.Number{Real: a, Imag: b, Jmag: c, Kmag: e},
+		quat.Number{Real: f, Imag: g, Jmag: h, Kmag: k}
+}
+
+// FromQuatParts returns a pointer to the Hamilton value with the real and
+// dual quaternion parts r and d.
+func FromQuatParts(r, d quat.Number) *dual.Hamilton {
+	return dual.NewHamilton(
+		r.Real, r.Imag, r.Jmag, r.Kmag,
+		d.Real, d.Imag, d.Jmag, d.Kmag,
+	)
+}
+
+// Number returns q as a dualquat.Number.
+func Number(q *dual.Hamilton) dualquat.Number {
+	r, d := QuatParts(q)
+	return dualquat.Number{Real: r, Dual: d}
+}
+
+// FromNumber returns a pointer to the Hamilton value equal to the
+// dualquat.Number x.
+func FromNumber(x dualquat.Number) *dual.Hamilton {
+	return FromQuatParts(x.Real, x.Dual)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package gonumdual
+
+import (
+	"math"
+	"testing"
+
+	"github.com/meirizarrygelpi/dual"
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/num/dualquat"
+	"gonum.org/v1/gonum/num/quat"
+	"gonum.org/v1/gonum/optimize"
+)
+
+// rosenbrock is the Rosenbrock function in n dimensions.
+func rosenbrock(x []dual.Real) *dual.Real {
+	z := new(dual.Real)
+	var a, b dual.Real
+	for i := 0; i+1 < len(x); i++ {
+		a.Mul(&x[i], &x[i])
+		a.Sub(&x[i+1], &a)
+		a.Mul(&a, &a)
+		a.Scal(&a, 100)
+		b.Sub(dual.NewReal(1, 0), &x[i])
+		z.Add(z, b.Mul(&b, &b))
+		z.Add(z, &a)
+	}
+	return z
+}
+
+func TestGrad(t *testing.T) {
+	x := []float64{-1.2, 1, 0.5}
+	g := make([]float64, len(x))
+	Grad(rosenbrock)(g, x)
+	want := []float64{
+		-400*x[0]*(x[1]-x[0]*x[0]) - 2*(1-x[0]),
+		200*(x[1]-x[0]*x[0]) - 400*x[1]*(x[2]-x[1]*x[1]) - 2*(1-x[1]),
+		200 * (x[2] - x[1]*x[1]),
+	}
+	for i := range g {
+		if math.Abs(g[i]-want[i]) > 1e-12 {
+			t.Errorf("grad[%d] = %v, want %v", i, g[i], want[i])
+		}
+	}
+	if got, want := Func(rosenbrock)(x), 24.2+25; math.Abs(got-want) > 1e-12 {
+		t.Errorf("Func = %v, want %v", got, want)
+	}
+	if e := GradError(rosenbrock, x, &fd.Settings{Formula: fd.Central}); e > 1e-5 {
+		t.Errorf("GradError = %v", e)
+	}
+}
+
+func TestProblem(t *testing.T) {
+	res, err := optimize.Minimize(Problem(rosenbrock), []float64{-1.2, 1, 0.5}, nil, &optimize.BFGS{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, x := range res.X {
+		if math.Abs(x-1) > 1e-6 {
+			t.Errorf("x[%d] = %v, want 1", i, x)
+		}
+	}
+}
+
+func TestNumber(t *testing.T) {
+	x := dual.NewHamilton(1, 2, 3, 4, 5, 6, 7, 8)
+	r, d := QuatParts(x)
+	if want := (quat.Number{Real: 1, Imag: 2, Jmag: 3, Kmag: 4}); r != want {
+		t.Errorf("real part = %v, want %v", r, want)
+	}
+	if want := (quat.Number{Real: 5, Imag: 6, Jmag: 7, Kmag: 8}); d != want {
+		t.Errorf("dual part = %v, want %v", d, want)
+	}
+	if got := FromNumber(Number(x)); !got.Equals(x) {
+		t.Errorf("FromNumber(Number(x)) = %v, want %v", got, x)
+	}
+	y := dual.NewHamilton(-1, 0.5, 0, 2, 0, -3, 1, 0.25)
+	want := new(dual.Hamilton).Compose(x, y)
+	if got := FromNumber(dualquat.Mul(Number(x), Number(y))); !got.EqualsTol(want, 1e-12) {
+		t.Errorf("dualquat.Mul = %v, want %v", got, want)
+	}
+}
@@ -6,71 +6,206 @@ package dual
 import (
 	"fmt"
 	"math"
-	"strings"
+	"math/cmplx"
 
 	"github.com/meirizarrygelpi/quat"
 )
 
 // A Hamilton represents a dual Hamilton quaternion as an ordered array of two
-// pointers to quat.Hamilton values.
-type Hamilton [2]*quat.Hamilton
+// quat.Hamilton values, the real part followed by the dual part. The eight
+// components are stored contiguously, and the zero value is the number zero.
+type Hamilton [2]quat.Hamilton
 
 var (
 	// Symbols for the canonical dual Hamilton quaternion basis.
 	symbHamilton = [8]string{"", "i", "j", "k", "ε", "εi", "εj", "εk"}
+
+	// ASCII symbols for the canonical dual Hamilton quaternion basis.
+	symbHamiltonASCII = [8]string{"", "i", "j", "k", "eps", "epsi", "epsj", "epsk"}
+
+	// LaTeX symbols for the canonical dual Hamilton quaternion basis.
+	symbHamiltonLatex = [8]string{
+		"", "i", "j", "k",
+		`\varepsilon`, `\varepsilon i`, `\varepsilon j`, `\varepsilon k`,
+	}
 )
 
+// Real returns the real part of z, a pointer to a quat.Hamilton value that
+// shares storage with z.
+func (z *Hamilton) Real() *quat.Hamilton {
+	return &z[0]
+}
+
+// Dual returns the dual part of z, a pointer to a quat.Hamilton value that
+// shares storage with z.
+func (z *Hamilton) Dual() *quat.Hamilton {
+	return &z[1]
+}
+
+// SetReal sets the real part of z equal to a.
+func (z *Hamilton) SetReal(a *quat.Hamilton) {
+	z[0] = *a
+}
+
+// SetDual sets the dual part of z equal to b.
+func (z *Hamilton) SetDual(b *quat.Hamilton) {
+	z[1] = *b
+}
+
+// Cartesian returns the eight Cartesian components of z.
+func (z *Hamilton) Cartesian() (a, b, c, d, e, f, g, h float64) {
+	a, b = real((z[0])[0]), imag((z[0])[0])
+	c, d = real((z[0])[1]), imag((z[0])[1])
+	e, f = real((z[1])[0]), imag((z[1])[0])
+	g, h = real((z[1])[1]), imag((z[1])[1])
+	return
+}
+
+// components returns the eight Cartesian components of z as a slice.
+func (z *Hamilton) components() []float64 {
+	v := make([]float64, 8)
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = z.Cartesian()
+	return v
+}
+
+// Set sets the eight Cartesian components of z, in the order returned by
+// Cartesian, and returns z.
+func (z *Hamilton) Set(a, b, c, d, e, f, g, h float64) *Hamilton {
+	z[0] = quat.Hamilton{complex(a, b), complex(c, d)}
+	z[1] = quat.Hamilton{complex(e, f), complex(g, h)}
+	return z
+}
+
 // String returns the string version of a Hamilton value. If z corresponds to
 // the dual Hamilton quaternion a + bi + cj + dk + eε + fεi + gεj + hεk, then
 // the string is "(a+bi+cj+dk+eε+fεi+gεj+hεk)", similar to complex128 values.
 func (z *Hamilton) String() string {
-	v := make([]float64, 8)
-	v[0], v[1] = real((z[0])[0]), imag((z[0])[0])
-	v[2], v[3] = real((z[0])[1]), imag((z[0])[1])
-	v[4], v[5] = real((z[1])[0]), imag((z[1])[0])
-	v[6], v[7] = real((z[1])[1]), imag((z[1])[1])
-	a := make([]string, 17)
-	a[0] = "("
-	a[1] = fmt.Sprintf("%g", v[0])
-	i := 1
-	for j := 2; j < 16; j = j + 2 {
-		switch {
-		case math.Signbit(v[i]):
-			a[j] = fmt.Sprintf("%g", v[i])
-		case math.IsInf(v[i], +1):
-			a[j] = "+Inf"
-		default:
-			a[j] = fmt.Sprintf("+%g", v[i])
+	return string(z.AppendString(nil))
+}
+
+// AppendString appends the string version of z, as returned by String, to dst
+// and returns the extended buffer.
+func (z *Hamilton) AppendString(dst []byte) []byte {
+	var v [8]float64
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = z.Cartesian()
+	return appendComponents(dst, v[:], symbols(symbHamilton[:], symbHamiltonASCII[:]))
+}
+
+// AppendText implements the encoding.TextAppender interface. It appends the
+// string version of z to b, and never returns an error.
+func (z *Hamilton) AppendText(b []byte) ([]byte, error) {
+	return z.AppendString(b), nil
+}
+
+// MarshalText implements encoding.TextMarshaler; see the package documentation.
+func (z Hamilton) MarshalText() ([]byte, error) {
+	return z.AppendString(nil), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It sets z
+// equal to the value represented by text, with the syntax of ParseHamilton. If text
+// is not valid, then z is left unchanged and the error is returned.
+func (z *Hamilton) UnmarshalText(text []byte) error {
+	y, err := ParseHamilton(string(text))
+	if err != nil {
+		return err
+	}
+	z.Copy(y)
+	return nil
+}
+
+// Format implements fmt.Formatter; see the package documentation.
+func (z *Hamilton) Format(s fmt.State, verb rune) {
+	v := z.components()
+	symb := symbols(symbHamilton[:], symbHamiltonASCII[:])
+	formatComponents(s, verb, v, symb, z)
+}
+
+// Latex returns the LaTeX representation of a Hamilton value.
+//
+// If z corresponds to a + bi + cj + dk + eε + fεi + gεj + hεk, then the string is
+// "a + bi + cj + dk + e\varepsilon + f\varepsilon i + g\varepsilon j + h\varepsilon k", with negative coefficients written with a minus
+// sign, and exponents written as powers of 10.
+func (z *Hamilton) Latex() string {
+	v := z.components()
+	return latexComponents(v, symbHamiltonLatex[:])
+}
+
+// FormatWith returns the string version of a Hamilton value, formatted with the
+// options o. A nil o prints the same string as String. With the Exponential
+// option, a unit dual quaternion other than the identity is printed in the
+// screw form of the rigid motion it encodes.
+func (z *Hamilton) FormatWith(o *FormatOptions) string {
+	if o != nil && o.Exponential {
+		if θ, u, m, ok := z.screw(); ok {
+			symb := o.basis(symbHamilton[:], symbHamiltonASCII[:], symbHamiltonLatex[:])
+			return o.screw(θ, u, m, symb)
 		}
-		a[j+1] = symbHamilton[i]
-		i++
 	}
-	a[16] = ")"
-	return strings.Join(a, "")
+	v := z.components()
+	return o.format(v, symbHamilton[:], symbHamiltonASCII[:], symbHamiltonLatex[:])
+}
+
+// GoString returns the Go syntax of a Hamilton value, as a call to NewHamilton such as
+// "dual.NewHamilton(1, 2, 3, 4, 5, 6, 7, 8)". It is used by the "%#v" verb.
+func (z *Hamilton) GoString() string {
+	v := z.components()
+	return goCall("dual.NewHamilton", v...)
 }
 
-// Equals returns true if z and y are equal.
+// Equals returns true if z and y are equal, within the absolute tolerance
+// Tolerance.
 func (z *Hamilton) Equals(y *Hamilton) bool {
-	if !z[0].Equals(y[0]) || !z[1].Equals(y[1]) {
-		return false
-	}
-	return true
+	return z.EqualsTol(y, Tolerance)
+}
+
+// EqualsTol returns true if each component of z differs from the matching
+// component of y by at most tol.
+func (z *Hamilton) EqualsTol(y *Hamilton, tol float64) bool {
+	return equalsTol(z.components(), y.components(), tol)
+}
+
+// Equal returns true if each component of z has the same bits as the matching
+// component of y. Unlike Equals, it tells 0 from -0, and a NaN is equal to
+// itself.
+func (z *Hamilton) Equal(y *Hamilton) bool {
+	return equalBits(z.components(), y.components())
+}
+
+// Hash64 returns a map-key hash of z; see the package documentation.
+func (z *Hamilton) Hash64(step float64) uint64 {
+	return hash64(z.components(), step)
+}
+
+// Cmp compares z and y in lexicographic order of their components, as given by
+// Cartesian, and returns -1, 0, or +1 as z is less than, equal to, or greater
+// than y. Each pair of components is compared as by cmp.Compare, so 0 equals -0
+// and a NaN is less than any other value and equal to another NaN. This is a
+// total order for sorting and searching, unrelated to the algebra.
+func (z *Hamilton) Cmp(y *Hamilton) int {
+	return compare(z.components(), y.components())
+}
+
+// Diff returns how z differs from y; see the package documentation.
+func (z *Hamilton) Diff(y *Hamilton) Diff {
+	return diff(z.components(), y.components(), symbols(symbHamilton[:], symbHamiltonASCII[:]))
+}
+
+// EqualsULP compares z and y in ULPs; see the package documentation.
+func (z *Hamilton) EqualsULP(y *Hamilton, ulps uint) bool {
+	return equalsULP(z.components(), y.components(), ulps)
 }
 
 // Copy copies y onto z, and returns z.
 func (z *Hamilton) Copy(y *Hamilton) *Hamilton {
-	z[0] = new(quat.Hamilton).Copy(y[0])
-	z[1] = new(quat.Hamilton).Copy(y[1])
+	*z = *y
 	return z
 }
 
 // NewHamilton returns a pointer to a Hamilton value made from eight given
 // float64 values.
 func NewHamilton(a, b, c, d, e, f, g, h float64) *Hamilton {
-	z := new(Hamilton)
-	z[0] = quat.NewHamilton(a, b, c, d)
-	z[1] = quat.NewHamilton(e, f, g, h)
-	return z
+	return new(Hamilton).Set(a, b, c, d, e, f, g, h)
 }
 
 // IsInf returns true if any of the components of z are infinite.
@@ -83,10 +218,7 @@ func (z *Hamilton) IsInf() bool {
 
 // HamiltonInf returns a pointer to a dual Hamilton quaternion infinity value.
 func HamiltonInf(a, b, c, d, e, f, g, h int) *Hamilton {
-	z := new(Hamilton)
-	z[0] = quat.HamiltonInf(a, b, c, d)
-	z[1] = quat.HamiltonInf(e, f, g, h)
-	return z
+	return &Hamilton{*quat.HamiltonInf(a, b, c, d), *quat.HamiltonInf(e, f, g, h)}
 }
 
 // IsNaN returns true if any component of z is NaN and neither is an
@@ -103,29 +235,45 @@ func (z *Hamilton) IsNaN() bool {
 
 // HamiltonNaN returns a pointer to a dual Hamilton quaternion NaN value.
 func HamiltonNaN() *Hamilton {
-	z := new(Hamilton)
-	z[0] = quat.HamiltonNaN()
-	z[1] = quat.HamiltonNaN()
-	return z
+	return &Hamilton{*quat.HamiltonNaN(), *quat.HamiltonNaN()}
 }
 
-// ScalR sets z equal to y scaled by a on the right, and returns z.
-//
-// This is a special case of Mul:
-// 		ScalR(y, a) = Mul(y, Hamilton{a, 0})
+// IsFinite returns true if every component of z is finite, that is, neither
+// infinite nor NaN.
+func (z *Hamilton) IsFinite() bool {
+	v := z.components()
+	return isFinite(v)
+}
+
+// Validate returns nil if every component of z is finite, and otherwise a
+// *NonFiniteError that reports the first component that is infinite or NaN.
+func (z *Hamilton) Validate() error {
+	v := z.components()
+	return validate(v, symbols(symbHamilton[:], symbHamiltonASCII[:]))
+}
+
+// ScalR sets z equal to y scaled by a on the right, and returns z. Both the
+// real and dual parts of y are multiplied by the full quaternion a on the
+// right:
+// 		ScalR(p + qε, a) = pa + qaε
+// Because of the conjugation in Mul, this is not the same as multiplying by
+// Hamilton{a, 0}.
 func (z *Hamilton) ScalR(y *Hamilton, a *quat.Hamilton) *Hamilton {
-	z[0] = new(quat.Hamilton).Mul(y[0], a)
-	z[1] = new(quat.Hamilton).Mul(y[1], a)
+	b := *a
+	z[0] = mulQuat(&y[0], &b)
+	z[1] = mulQuat(&y[1], &b)
 	return z
 }
 
-// ScalL sets z equal to y scaled by a on the left, and returns z.
-//
-// This is a special case of Mul:
-// 		ScalL(y, a) = Mul(Hamilton{a, 0}, y)
+// ScalL sets z equal to y scaled by a on the left, and returns z. Both the
+// real and dual parts of y are multiplied by the full quaternion a on the left:
+// 		ScalL(a, p + qε) = ap + aqε
+// Because of the conjugation in Mul, this is not the same as multiplying by
+// Hamilton{a, 0}.
 func (z *Hamilton) ScalL(a *quat.Hamilton, y *Hamilton) *Hamilton {
-	z[0] = new(quat.Hamilton).Mul(a, y[0])
-	z[1] = new(quat.Hamilton).Mul(a, y[1])
+	b := *a
+	z[0] = mulQuat(&b, &y[0])
+	z[1] = mulQuat(&b, &y[1])
 	return z
 }
 
@@ -134,34 +282,70 @@ func (z *Hamilton) ScalL(a *quat.Hamilton, y *Hamilton) *Hamilton {
 // This is a special case of Mul:
 // 		Dil(y, a) = Mul(y, Hamilton{quat.Hamilton{a, 0, 0, 0}, 0})
 func (z *Hamilton) Dil(y *Hamilton, a float64) *Hamilton {
-	z[0] = new(quat.Hamilton).Dil(y[0], a)
-	z[1] = new(quat.Hamilton).Dil(y[1], a)
+	z[0].Dil(&y[0], a)
+	z[1].Dil(&y[1], a)
 	return z
 }
 
-// Neg sets z equal to the negative of y, and returns z.
+// Neg sets z equal to the negative of y, and returns z. It flips the sign of
+// each component, zeros included.
 func (z *Hamilton) Neg(y *Hamilton) *Hamilton {
-	return z.Dil(y, -1)
+	z[0] = quat.Hamilton{-y[0][0], -y[0][1]}
+	z[1] = quat.Hamilton{-y[1][0], -y[1][1]}
+	return z
 }
 
-// Conj sets z equal to the conjugate of y, and returns z.
+// Conj sets z equal to the conjugate of y, and returns z. It flips the sign of
+// each component but the first, zeros included.
 func (z *Hamilton) Conj(y *Hamilton) *Hamilton {
-	z[0] = new(quat.Hamilton).Conj(y[0])
-	z[1] = new(quat.Hamilton).Neg(y[1])
+	z[0] = quat.Hamilton{cmplx.Conj(y[0][0]), -y[0][1]}
+	z[1] = quat.Hamilton{-y[1][0], -y[1][1]}
+	return z
+}
+
+// QuatConj sets z equal to the quaternion conjugate r* + εd* of y = r + εd,
+// and returns z. It is an anti-automorphism of Compose:
+// 		QuatConj(xy) = QuatConj(y)QuatConj(x)
+// For a unit dual quaternion it is the inverse motion.
+func (z *Hamilton) QuatConj(y *Hamilton) *Hamilton {
+	z[0] = quat.Hamilton{cmplx.Conj(y[0][0]), -y[0][1]}
+	z[1] = quat.Hamilton{cmplx.Conj(y[1][0]), -y[1][1]}
+	return z
+}
+
+// DualConj sets z equal to the dual conjugate r - εd of y = r + εd, and
+// returns z. It is an automorphism of both Mul and Compose:
+// 		DualConj(xy) = DualConj(x)DualConj(y)
+func (z *Hamilton) DualConj(y *Hamilton) *Hamilton {
+	z[0] = y[0]
+	z[1] = quat.Hamilton{-y[1][0], -y[1][1]}
+	return z
+}
+
+// QuatDualConj sets z equal to the combined conjugate r* - εd* of y = r + εd,
+// and returns z. It is an anti-automorphism of Compose, and maps the dual
+// quaternion 1 + εp of a point p to itself.
+//
+// Conj, which conjugates r and negates d, is the conjugate that matches Mul:
+// 		Conj(xy) = Conj(y)Conj(x)
+// with Mul in place of Compose.
+func (z *Hamilton) QuatDualConj(y *Hamilton) *Hamilton {
+	z[0] = quat.Hamilton{cmplx.Conj(y[0][0]), -y[0][1]}
+	z[1] = quat.Hamilton{-cmplx.Conj(y[1][0]), y[1][1]}
 	return z
 }
 
 // Add sets z equal to the sum of x and y, and returns z.
 func (z *Hamilton) Add(x, y *Hamilton) *Hamilton {
-	z[0] = new(quat.Hamilton).Add(x[0], y[0])
-	z[1] = new(quat.Hamilton).Add(x[1], y[1])
+	z[0].Add(&x[0], &y[0])
+	z[1].Add(&x[1], &y[1])
 	return z
 }
 
 // Sub sets z equal to the difference of x and y, and returns z.
 func (z *Hamilton) Sub(x, y *Hamilton) *Hamilton {
-	z[0] = new(quat.Hamilton).Sub(x[0], y[0])
-	z[1] = new(quat.Hamilton).Sub(x[1], y[1])
+	z[0].Sub(&x[0], &y[0])
+	z[1].Sub(&x[1], &y[1])
 	return z
 }
 
@@ -192,27 +376,87 @@ func (z *Hamilton) Sub(x, y *Hamilton) *Hamilton {
 // 		εj * εk = εk * εj = 0
 // This multiplication rule is noncommutative and nonassociative.
 func (z *Hamilton) Mul(x, y *Hamilton) *Hamilton {
-	p := new(Hamilton).Copy(x)
-	q := new(Hamilton).Copy(y)
-	z[0] = new(quat.Hamilton).Mul(p[0], q[0])
-	z[1] = new(quat.Hamilton).Add(
-		new(quat.Hamilton).Mul(q[1], p[0]),
-		new(quat.Hamilton).Mul(p[1], q[0].Conj(q[0])),
-	)
+	// A zero dual part leaves out its term, unless the other factor has a NaN
+	// that the term must carry.
+	var s, t quat.Hamilton
+	if y[1] != (quat.Hamilton{}) || quatHasNaN(&x[0]) {
+		s = mulQuat(&y[1], &x[0])
+	}
+	if x[1] != (quat.Hamilton{}) || quatHasNaN(&y[0]) {
+		t.Conj(&y[0])
+		t = mulQuat(&x[1], &t)
+	}
+	z[0] = mulQuat(&x[0], &y[0])
+	z[1].Add(&s, &t)
+	return z
+}
+
+// Compose sets z equal to the dual quaternion product of x and y, and returns
+// z:
+// 		(r₁ + εd₁)(r₂ + εd₂) = r₁r₂ + ε(r₁d₂ + d₁r₂)
+// This is the associative product under which unit dual quaternions represent
+// rigid motions, with xy the motion y followed by x. It differs from Mul, whose
+// dual part multiplies in a different order and conjugates.
+func (z *Hamilton) Compose(x, y *Hamilton) *Hamilton {
+	*z = compose(x, y)
+	return z
+}
+
+// MulAdd sets z equal to x*y + w, and returns z. Each part is computed with
+// fused multiply-adds.
+func (z *Hamilton) MulAdd(x, y, w *Hamilton) *Hamilton {
+	var c quat.Hamilton
+	c.Conj(&y[0])
+	g := addMulQuatFMA(addMulQuatFMA(w[1], &y[1], &x[0]), &x[1], &c)
+	if quatHasNaN(&g) {
+		var p Hamilton
+		p.Mul(x, y)
+		g.Add(&p[1], &w[1])
+	}
+	z[0] = addMulQuatFMA(w[0], &x[0], &y[0])
+	z[1] = g
 	return z
 }
 
 // Commutator sets z equal to the commutator of x and y, and returns z.
 func (z *Hamilton) Commutator(x, y *Hamilton) *Hamilton {
-	return z.Sub(new(Hamilton).Mul(x, y), new(Hamilton).Mul(y, x))
+	var p, q Hamilton
+	return z.Sub(p.Mul(x, y), q.Mul(y, x))
 }
 
 // Associator sets z equal to the associator of w, x, and y, and returns z.
 func (z *Hamilton) Associator(w, x, y *Hamilton) *Hamilton {
-	return z.Sub(
-		new(Hamilton).Mul(new(Hamilton).Mul(w, x), y),
-		new(Hamilton).Mul(w, new(Hamilton).Mul(x, y)),
-	)
+	var p, q Hamilton
+	p.Mul(p.Mul(w, x), y)
+	q.Mul(w, q.Mul(x, y))
+	return z.Sub(&p, &q)
+}
+
+// mulQuat returns the product of the quaternions x and y, seen as pairs of
+// complex numbers with (a, b)(c, d) = (ac - bd*, ad + bc*).
+func mulQuat(x, y *quat.Hamilton) quat.Hamilton {
+	a, b := x[0], x[1]
+	c, d := y[0], y[1]
+	return quat.Hamilton{
+		(a * c) - (b * cmplx.Conj(d)),
+		(a * d) + (b * cmplx.Conj(c)),
+	}
+}
+
+// addMulQuatFMA returns w + xy for the quaternions w, x, and y, with each part
+// computed with fused multiply-adds as in mulQuat.
+func addMulQuatFMA(w quat.Hamilton, x, y *quat.Hamilton) quat.Hamilton {
+	a, b := x[0], x[1]
+	c, d := y[0], y[1]
+	return quat.Hamilton{
+		addMulFMA(addMulFMA(w[0], a, c), -b, cmplx.Conj(d)),
+		addMulFMA(addMulFMA(w[1], a, d), b, cmplx.Conj(c)),
+	}
+}
+
+// quatHasNaN returns true if any component of q is NaN.
+func quatHasNaN(q *quat.Hamilton) bool {
+	return isNaNParts(q[0]) || isNaNParts(q[1])
 }
 
 // Quad returns the quadrance of z, a float64 value.
@@ -220,8 +464,260 @@ func (z *Hamilton) Quad() float64 {
 	return z[0].Quad()
 }
 
-// IsZeroDiv returns true if z is a zero divisor. This is equivalent to
-// z being nilpotent (i.e. z² = 0).
+// IsZeroDiv returns true if z is a zero divisor. A dual quaternion p + qε is a
+// zero divisor exactly when its real part p vanishes, that is, when all four
+// components of p are zero to within the package tolerance. This is equivalent
+// to z being nilpotent (i.e. z² = 0).
 func (z *Hamilton) IsZeroDiv() bool {
-	return !z[0].Equals(&quat.Hamilton{0, 0})
+	p := &z[0]
+	return !notEquals(real(p[0]), 0) && !notEquals(imag(p[0]), 0) &&
+		!notEquals(real(p[1]), 0) && !notEquals(imag(p[1]), 0)
+}
+
+// ScalarPart returns the scalar parts of the real and dual quaternion parts of
+// z: the components a and e of a + bi + cj + dk + ε(e + fi + gj + hk).
+func (z *Hamilton) ScalarPart() (a, e float64) {
+	return real(z[0][0]), real(z[1][0])
+}
+
+// VectorPart returns the vector parts of the real and dual quaternion parts of
+// z, as 3-vectors: u = (b, c, d) and v = (f, g, h) for
+// a + bi + cj + dk + ε(e + fi + gj + hk).
+func (z *Hamilton) VectorPart() (u, v [3]float64) {
+	u = [3]float64{imag(z[0][0]), real(z[0][1]), imag(z[0][1])}
+	v = [3]float64{imag(z[1][0]), real(z[1][1]), imag(z[1][1])}
+	return u, v
+}
+
+// IsPure returns true if both scalar parts of z are zero to within the package
+// tolerance, so that z is the dual 3-vector u + εv of its vector parts. Lines,
+// twists, and wrenches are pure dual quaternions.
+func (z *Hamilton) IsPure() bool {
+	a, e := z.ScalarPart()
+	return !notEquals(a, 0) && !notEquals(e, 0)
+}
+
+// VectorDot returns the dot product u · v of the vector parts of the real and
+// dual quaternion parts of z. For a unit dual quaternion it equals the product
+// of the scalar parts, with the opposite sign.
+func (z *Hamilton) VectorDot() float64 {
+	u, v := z.VectorPart()
+	return dot3(u, v)
+}
+
+// VectorCross returns the cross product u × v of the vector parts of the real
+// and dual quaternion parts of z.
+func (z *Hamilton) VectorCross() [3]float64 {
+	u, v := z.VectorPart()
+	return cross3(u, v)
+}
+
+// Cond returns a condition estimate for division by z: the ratio of the
+// Euclidean norm of all eight components of z to that of its real quaternion
+// part. As with Real.Cond, it is +Inf for a zero real part.
+func (z *Hamilton) Cond() float64 {
+	var v [8]float64
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = z.Cartesian()
+	return condition(v[:], 4)
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
+// then Inv panics.
+//
+// If y = r + sε, then the inverse is
+// 		r⁻¹ - (s(r⁻¹)*)r⁻¹ε
+// which is both a left and a right inverse. The quaternion inverse r⁻¹ scales
+// r by a power of two before computing its quadrance, as in Smith's algorithm
+// for complex division, so the result does not overflow or underflow unless the
+// inverse itself does.
+func (z *Hamilton) Inv(y *Hamilton) *Hamilton {
+	if y.IsZeroDiv() {
+		panic("zero divisor")
+	}
+	u := invQuat(&y[0])
+	var c quat.Hamilton
+	c.Conj(&u)
+	c = mulQuat(&y[1], &c)
+	z[0] = u
+	z[1] = mulQuat(&c, &u)
+	z[1].Neg(&z[1])
+	return z
+}
+
+// Quo sets z equal to the quotient of x and y, that is, x times the inverse of
+// y, and returns z. If y is a zero divisor, then Quo panics. Because Mul is not
+// associative, Quo(x, y) times y need not equal x.
+func (z *Hamilton) Quo(x, y *Hamilton) *Hamilton {
+	if y.IsZeroDiv() {
+		panic("zero divisor denominator")
+	}
+	var u Hamilton
+	return z.Mul(x, u.Inv(y))
+}
+
+// InvChecked sets z equal to the inverse of y, and returns z and a nil error.
+// If y is a zero divisor, then z is left unchanged and ErrZeroDivisor is
+// returned in place of the panic of Inv.
+func (z *Hamilton) InvChecked(y *Hamilton) (*Hamilton, error) {
+	if y.IsZeroDiv() {
+		return z, ErrZeroDivisor
+	}
+	return z.Inv(y), nil
+}
+
+// QuoChecked sets z equal to the quotient of x and y, and returns z and a nil
+// error. If y is a zero divisor, then z is left unchanged and ErrZeroDivisor is
+// returned in place of the panic of Quo.
+func (z *Hamilton) QuoChecked(x, y *Hamilton) (*Hamilton, error) {
+	if y.IsZeroDiv() {
+		return z, ErrZeroDivisor
+	}
+	return z.Quo(x, y), nil
+}
+
+// InvNaN sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then every component of z is set to NaN in place of the panic of
+// Inv, so that the failure travels with the value, as with float64 division.
+func (z *Hamilton) InvNaN(y *Hamilton) *Hamilton {
+	if y.IsZeroDiv() {
+		return z.Copy(HamiltonNaN())
+	}
+	return z.Inv(y)
+}
+
+// QuoNaN sets z equal to the quotient of x and y, and returns z. If y is a zero
+// divisor, then every component of z is set to NaN in place of the panic of
+// Quo.
+func (z *Hamilton) QuoNaN(x, y *Hamilton) *Hamilton {
+	if y.IsZeroDiv() {
+		return z.Copy(HamiltonNaN())
+	}
+	return z.Quo(x, y)
+}
+
+// invQuat returns the inverse r*/|r|² of the nonzero quaternion r. The
+// components are first scaled by a power of two that brings the largest of
+// them near one, so that the quadrance neither overflows nor underflows.
+func invQuat(r *quat.Hamilton) quat.Hamilton {
+	v := [4]float64{real(r[0]), imag(r[0]), real(r[1]), imag(r[1])}
+	m := 0.0
+	for _, x := range v {
+		m = math.Max(m, math.Abs(x))
+	}
+	_, e := math.Frexp(m)
+	q := 0.0
+	for i := range v {
+		v[i] = math.Ldexp(v[i], -e)
+		q += v[i] * v[i]
+	}
+	for i := range v {
+		v[i] = math.Ldexp(v[i]/q, -e)
+	}
+	return quat.Hamilton{complex(v[0], -v[1]), complex(-v[2], -v[3])}
+}
+
+// HamiltonRotation returns a pointer to the unit dual quaternion of the
+// rotation by the angle θ about the axis through the origin with direction u:
+// 		cos(θ/2) + sin(θ/2)u
+// The direction need not be a unit vector. If u is zero, then the rotation is
+// the identity.
+func HamiltonRotation(u [3]float64, θ float64) *Hamilton {
+	n := norm3(u)
+	if n == 0 {
+		return NewHamilton(1, 0, 0, 0, 0, 0, 0, 0)
+	}
+	u = scale3(u, 1/n)
+	s, c := math.Sincos(θ / 2)
+	return NewHamilton(c, s*u[0], s*u[1], s*u[2], 0, 0, 0, 0)
+}
+
+// HamiltonTranslation returns a pointer to the unit dual quaternion of the
+// translation by t:
+// 		1 + ½tε
+// A rotation r followed by the translation t is their Compose, 1 + ½tε times
+// r.
+func HamiltonTranslation(t [3]float64) *Hamilton {
+	return NewHamilton(1, 0, 0, 0, 0, t[0]/2, t[1]/2, t[2]/2)
+}
+
+// screw returns the screw parameters of the rigid motion encoded in z, seen as
+// a unit dual quaternion r + εd with d = ½tr: the dual angle θ + dε of the
+// motion, and the direction u and moment m of its screw axis. If z is not a
+// unit dual quaternion, or if it is the identity, then ok is false.
+func (z *Hamilton) screw() (θ *DualAngle, u, m [3]float64, ok bool) {
+	w, e := z.ScalarPart()
+	v, f := z.VectorPart()
+	if notEquals(z.Quad(), 1) || notEquals((w*e)+dot3(v, f), 0) {
+		return nil, u, m, false
+	}
+	return z.unitScrew()
+}
+
+// unitScrew is screw without the check that z is a unit dual quaternion, for
+// values that are unit up to rounding, such as normalized products.
+func (z *Hamilton) unitScrew() (θ *DualAngle, u, m [3]float64, ok bool) {
+	w, _ := z.ScalarPart()
+	v, _ := z.VectorPart()
+	t := translation3(z)
+	s := norm3(v)
+	if s <= delta {
+		// A pure translation has its axis through the origin.
+		d := norm3(t)
+		if d <= delta {
+			return nil, u, m, false
+		}
+		return NewDualAngle(0, d), scale3(t, 1/d), m, true
+	}
+	a := 2 * math.Atan2(s, w)
+	u = scale3(v, 1/s)
+	d := dot3(t, u)
+	p := sub3(t, scale3(u, d))
+	p = scale3(add3(p, scale3(cross3(u, t), 1/math.Tan(a/2))), 0.5)
+	return NewDualAngle(a, d), u, cross3(p, u), true
+}
+
+// The following functions provide a value-semantics alternative to the methods
+// of Hamilton. They do not modify any of their arguments, and return a new value. Hamilton
+// values are comparable, so they can also be used as map keys.
+
+// HamiltonNeg returns the negative of x.
+func HamiltonNeg(x Hamilton) Hamilton {
+	var z Hamilton
+	z.Neg(&x)
+	return z
+}
+
+// HamiltonConj returns the conjugate of x.
+func HamiltonConj(x Hamilton) Hamilton {
+	var z Hamilton
+	z.Conj(&x)
+	return z
+}
+
+// HamiltonDil returns x dilated by a.
+func HamiltonDil(x Hamilton, a float64) Hamilton {
+	var z Hamilton
+	z.Dil(&x, a)
+	return z
+}
+
+// HamiltonAdd returns the sum of x and y.
+func HamiltonAdd(x, y Hamilton) Hamilton {
+	var z Hamilton
+	z.Add(&x, &y)
+	return z
+}
+
+// HamiltonSub returns the difference of x and y.
+func HamiltonSub(x, y Hamilton) Hamilton {
+	var z Hamilton
+	z.Sub(&x, &y)
+	return z
+}
+
+// HamiltonMul returns the product of x and y.
+func HamiltonMul(x, y Hamilton) Hamilton {
+	var z Hamilton
+	z.Mul(&x, &y)
+	return z
 }
@@ -0,0 +1,92 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"testing"
+
+	"github.com/meirizarrygelpi/quat"
+)
+
+func TestHamiltonIsZeroDiv(t *testing.T) {
+	var tests = []struct {
+		z    *Hamilton
+		want bool
+	}{
+		{NewHamilton(0, 0, 0, 0, 0, 0, 0, 0), true},
+		{NewHamilton(1, 0, 0, 0, 0, 0, 0, 0), false},
+		{NewHamilton(0, 0, 1, 0, 0, 0, 0, 0), false},
+		{NewHamilton(0, 0, 0, 1, 0, 0, 0, 0), false},
+		{NewHamilton(0, 0, 0, 0, 0, 0, 1, 0), true},
+		{NewHamilton(0, 1e-12, 0, 0, 1, 2, 3, 4), true},
+	}
+	for _, test := range tests {
+		if got := test.z.IsZeroDiv(); got != test.want {
+			t.Errorf("IsZeroDiv(%v) = %v", test.z, got)
+		}
+	}
+}
+
+func TestHamiltonVectorPart(t *testing.T) {
+	z := NewHamilton(1, 2, 3, 4, 5, 6, 7, 8)
+	if a, e := z.ScalarPart(); a != 1 || e != 5 {
+		t.Errorf("ScalarPart = %v, %v, want 1, 5", a, e)
+	}
+	u, v := z.VectorPart()
+	if u != [3]float64{2, 3, 4} || v != [3]float64{6, 7, 8} {
+		t.Errorf("VectorPart = %v, %v", u, v)
+	}
+	if got := z.VectorDot(); got != 12+21+32 {
+		t.Errorf("VectorDot = %v, want 65", got)
+	}
+	if got, want := z.VectorCross(), [3]float64{-4, 8, -4}; got != want {
+		t.Errorf("VectorCross = %v, want %v", got, want)
+	}
+	if z.IsPure() || !NewHamilton(0, 1, 2, 3, 0, 4, 5, 6).IsPure() {
+		t.Errorf("IsPure is wrong")
+	}
+}
+
+func TestHamiltonScal(t *testing.T) {
+	a := quat.NewHamilton(1, 2, 3, 4)
+	y := NewHamilton(5, 6, 7, 8, 9, 10, 11, 12)
+	p, q := y.Real(), y.Dual()
+	want := &Hamilton{*new(quat.Hamilton).Mul(a, p), *new(quat.Hamilton).Mul(a, q)}
+	if got := new(Hamilton).ScalL(a, y); !got.Equals(want) {
+		t.Errorf("ScalL = %v, want %v", got, want)
+	}
+	want = &Hamilton{*new(quat.Hamilton).Mul(p, a), *new(quat.Hamilton).Mul(q, a)}
+	if got := new(Hamilton).ScalR(y, a); !got.Equals(want) {
+		t.Errorf("ScalR = %v, want %v", got, want)
+	}
+	if new(Hamilton).ScalL(a, y).Equals(new(Hamilton).ScalR(y, a)) {
+		t.Errorf("ScalL and ScalR agree for non-commuting values")
+	}
+}
+
+func BenchmarkHamiltonMul(b *testing.B) {
+	x, y := NewHamilton(1, 2, 3, 4, 5, 6, 7, 8), NewHamilton(8, 7, 6, 5, 4, 3, 2, 1)
+	z := new(Hamilton)
+	for i := 0; i < b.N; i++ {
+		z.Mul(x, y)
+	}
+}
+
+func TestHamiltonValue(t *testing.T) {
+	var z Hamilton
+	z.Add(&z, NewHamilton(1, 2, 3, 4, 5, 6, 7, 8))
+	if !z.Equals(NewHamilton(1, 2, 3, 4, 5, 6, 7, 8)) {
+		t.Errorf("Add on zero value = %v", &z)
+	}
+	z.Real().Dil(z.Real(), 2)
+	if !z.Equals(NewHamilton(2, 4, 6, 8, 5, 6, 7, 8)) {
+		t.Errorf("Real does not share storage: %v", &z)
+	}
+	a := quat.NewHamilton(0, 0, 0, 0)
+	z.SetDual(a)
+	a[0] = 1
+	if !z.Equals(NewHamilton(2, 4, 6, 8, 0, 0, 0, 0)) {
+		t.Errorf("SetDual does not copy: %v", &z)
+	}
+}
@@ -6,73 +6,202 @@ package dual
 import (
 	"fmt"
 	"math"
-	"strings"
 )
 
-// A Hyper represents a hyper dual number as an ordered array of two pointers
-// to Real values.
-type Hyper [2]*Real
+// A Hyper represents a hyper dual number as an ordered array of four float64
+// values: the components of the real part followed by those of the dual part.
+// The zero value is the number zero.
+type Hyper [4]float64
 
 var (
 	// Symbols for the canonical hyper dual basis.
 	symbHyper = [4]string{"", "ε", "η", "εη"}
+
+	// ASCII symbols for the canonical hyper dual basis.
+	symbHyperASCII = [4]string{"", "eps", "eta", "epseta"}
+
+	// LaTeX symbols for the canonical hyper dual basis.
+	symbHyperLatex = [4]string{"", `\varepsilon`, `\eta`, `\varepsilon\eta`}
 )
 
+// Real returns the real part of z, a pointer to a Real value that shares
+// storage with z.
+func (z *Hyper) Real() *Real {
+	return (*Real)(z[0:2])
+}
+
+// Dual returns the dual part of z, a pointer to a Real value that shares
+// storage with z.
+func (z *Hyper) Dual() *Real {
+	return (*Real)(z[2:4])
+}
+
+// SetReal sets the real part of z equal to a.
+func (z *Hyper) SetReal(a *Real) {
+	z[0], z[1] = a[0], a[1]
+}
+
+// SetDual sets the dual part of z equal to b.
+func (z *Hyper) SetDual(b *Real) {
+	z[2], z[3] = b[0], b[1]
+}
+
+// Cartesian returns the four Cartesian components of z.
+func (z *Hyper) Cartesian() (a, b, c, d float64) {
+	return z[0], z[1], z[2], z[3]
+}
+
+// Eps returns the ε coefficient of z. For z = f(x + ε + η), it is the first
+// derivative f′(x).
+func (z *Hyper) Eps() float64 {
+	return z[1]
+}
+
+// Eta returns the η coefficient of z. For z = f(x + ε + η), it is the first
+// derivative f′(x), as is Eps.
+func (z *Hyper) Eta() float64 {
+	return z[2]
+}
+
+// Cross returns the εη coefficient of z. For z = f(x + ε + η), it is the
+// second derivative f″(x).
+func (z *Hyper) Cross() float64 {
+	return z[3]
+}
+
+// Set sets the four Cartesian components of z, in the order returned by
+// Cartesian, and returns z.
+func (z *Hyper) Set(a, b, c, d float64) *Hyper {
+	*z = Hyper{a, b, c, d}
+	return z
+}
+
 // String returns the string representation of a Hyper value.
 //
 // If z corresponds to the hyper dual number a + bε + cη + dεη, then the string
 // is "(a+bε+cη+dεη)", similar to complex128 values.
 func (z *Hyper) String() string {
-	v := make([]float64, 4)
-	v[0], v[1] = (z[0])[0], (z[0])[1]
-	v[2], v[3] = (z[1])[0], (z[1])[1]
-	a := make([]string, 9)
-	a[0] = "("
-	a[1] = fmt.Sprintf("%g", v[0])
-	i := 1
-	for j := 2; j < 8; j = j + 2 {
-		switch {
-		case math.Signbit(v[i]):
-			a[j] = fmt.Sprintf("%g", v[i])
-		case math.IsInf(v[i], +1):
-			a[j] = "+Inf"
-		default:
-			a[j] = fmt.Sprintf("+%g", v[i])
-		}
-		a[j+1] = symbHyper[i]
-		i++
-	}
-	a[8] = ")"
-	return strings.Join(a, "")
-}
-
-// Equals returns true if z and y are equal.
-func (z *Hyper) Equals(y *Hyper) bool {
-	if !z[0].Equals(y[0]) || !z[1].Equals(y[1]) {
-		return false
+	return string(z.AppendString(nil))
+}
+
+// AppendString appends the string version of z, as returned by String, to dst
+// and returns the extended buffer.
+func (z *Hyper) AppendString(dst []byte) []byte {
+	return appendComponents(dst, z[:], symbols(symbHyper[:], symbHyperASCII[:]))
+}
+
+// AppendText implements the encoding.TextAppender interface. It appends the
+// string version of z to b, and never returns an error.
+func (z *Hyper) AppendText(b []byte) ([]byte, error) {
+	return z.AppendString(b), nil
+}
+
+// MarshalText implements encoding.TextMarshaler; see the package documentation.
+func (z Hyper) MarshalText() ([]byte, error) {
+	return z.AppendString(nil), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It sets z
+// equal to the value represented by text, with the syntax of ParseHyper. If text
+// is not valid, then z is left unchanged and the error is returned.
+func (z *Hyper) UnmarshalText(text []byte) error {
+	y, err := ParseHyper(string(text))
+	if err != nil {
+		return err
 	}
-	return true
+	z.Copy(y)
+	return nil
+}
+
+// Format implements fmt.Formatter; see the package documentation.
+func (z *Hyper) Format(s fmt.State, verb rune) {
+	v := z[:]
+	symb := symbols(symbHyper[:], symbHyperASCII[:])
+	formatComponents(s, verb, v, symb, z)
+}
+
+// Latex returns the LaTeX representation of a Hyper value.
+//
+// If z corresponds to a + bε + cη + dεη, then the string is
+// "a + b\varepsilon + c\eta + d\varepsilon\eta", with negative coefficients written with a minus
+// sign, and exponents written as powers of 10.
+func (z *Hyper) Latex() string {
+	v := z[:]
+	return latexComponents(v, symbHyperLatex[:])
+}
+
+// FormatWith returns the string version of a Hyper value, formatted with the
+// options o. A nil o prints the same string as String.
+func (z *Hyper) FormatWith(o *FormatOptions) string {
+	v := z[:]
+	return o.format(v, symbHyper[:], symbHyperASCII[:], symbHyperLatex[:])
+}
+
+// GoString returns the Go syntax of a Hyper value, as a call to NewHyper such as
+// "dual.NewHyper(1, 2, 3, 4)". It is used by the "%#v" verb.
+func (z *Hyper) GoString() string {
+	v := z[:]
+	return goCall("dual.NewHyper", v...)
+}
+
+// Equals returns true if z and y are equal, within the absolute tolerance
+// Tolerance.
+func (z *Hyper) Equals(y *Hyper) bool {
+	return z.EqualsTol(y, Tolerance)
+}
+
+// EqualsTol returns true if each component of z differs from the matching
+// component of y by at most tol.
+func (z *Hyper) EqualsTol(y *Hyper, tol float64) bool {
+	return equalsTol(z[:], y[:], tol)
+}
+
+// Equal returns true if each component of z has the same bits as the matching
+// component of y. Unlike Equals, it tells 0 from -0, and a NaN is equal to
+// itself.
+func (z *Hyper) Equal(y *Hyper) bool {
+	return equalBits(z[:], y[:])
+}
+
+// Hash64 returns a map-key hash of z; see the package documentation.
+func (z *Hyper) Hash64(step float64) uint64 {
+	return hash64(z[:], step)
+}
+
+// Cmp compares z and y in lexicographic order of their components, as given by
+// Cartesian, and returns -1, 0, or +1 as z is less than, equal to, or greater
+// than y. Each pair of components is compared as by cmp.Compare, so 0 equals -0
+// and a NaN is less than any other value and equal to another NaN. This is a
+// total order for sorting and searching, unrelated to the algebra.
+func (z *Hyper) Cmp(y *Hyper) int {
+	return compare(z[:], y[:])
+}
+
+// Diff returns how z differs from y; see the package documentation.
+func (z *Hyper) Diff(y *Hyper) Diff {
+	return diff(z[:], y[:], symbols(symbHyper[:], symbHyperASCII[:]))
+}
+
+// EqualsULP compares z and y in ULPs; see the package documentation.
+func (z *Hyper) EqualsULP(y *Hyper, ulps uint) bool {
+	return equalsULP(z[:], y[:], ulps)
 }
 
 // Copy copies y onto z, and returns z.
 func (z *Hyper) Copy(y *Hyper) *Hyper {
-	z[0] = new(Real).Copy(y[0])
-	z[1] = new(Real).Copy(y[1])
+	*z = *y
 	return z
 }
 
 // NewHyper returns a pointer to a Hyper value made from four given float64
 // values.
 func NewHyper(a, b, c, d float64) *Hyper {
-	z := new(Hyper)
-	z[0] = NewReal(a, b)
-	z[1] = NewReal(c, d)
-	return z
+	return &Hyper{a, b, c, d}
 }
 
 // IsInf returns true if any of the components of z are infinite.
 func (z *Hyper) IsInf() bool {
-	if z[0].IsInf() || z[1].IsInf() {
+	if z.Real().IsInf() || z.Dual().IsInf() {
 		return true
 	}
 	return false
@@ -80,19 +209,16 @@ func (z *Hyper) IsInf() bool {
 
 // HyperInf returns a pointer to a hyper dual infinity value.
 func HyperInf(a, b, c, d int) *Hyper {
-	z := new(Hyper)
-	z[0] = RealInf(a, b)
-	z[1] = RealInf(c, d)
-	return z
+	return &Hyper{math.Inf(a), math.Inf(b), math.Inf(c), math.Inf(d)}
 }
 
 // IsNaN returns true if any component of z is NaN and neither is an
 // infinity.
 func (z *Hyper) IsNaN() bool {
-	if z[0].IsInf() || z[1].IsInf() {
+	if z.Real().IsInf() || z.Dual().IsInf() {
 		return false
 	}
-	if z[0].IsNaN() || z[1].IsNaN() {
+	if z.Real().IsNaN() || z.Dual().IsNaN() {
 		return true
 	}
 	return false
@@ -100,56 +226,76 @@ func (z *Hyper) IsNaN() bool {
 
 // HyperNaN returns a pointer to a hyper dual NaN value.
 func HyperNaN() *Hyper {
-	z := new(Hyper)
-	z[0] = RealNaN()
-	z[1] = RealNaN()
-	return z
+	nan := math.NaN()
+	return &Hyper{nan, nan, nan, nan}
+}
+
+// IsFinite returns true if every component of z is finite, that is, neither
+// infinite nor NaN.
+func (z *Hyper) IsFinite() bool {
+	v := z[:]
+	return isFinite(v)
+}
+
+// Validate returns nil if every component of z is finite, and otherwise a
+// *NonFiniteError that reports the first component that is infinite or NaN.
+func (z *Hyper) Validate() error {
+	v := z[:]
+	return validate(v, symbols(symbHyper[:], symbHyperASCII[:]))
 }
 
 // Scal sets z equal to y scaled by a (with a being a Real pointer),
 // and returns z.
 //
 // This is a special case of Mul:
-// 		Scal(y, a) = Mul(y, Hyper{a, 0})
+// 		Scal(y, a) = Mul(y, Hyper{a[0], a[1], 0, 0})
 func (z *Hyper) Scal(y *Hyper, a *Real) *Hyper {
-	z[0] = new(Real).Mul(y[0], a)
-	z[1] = new(Real).Mul(y[1], a)
+	b := *a
+	z.Real().Mul(y.Real(), &b)
+	z.Dual().Mul(y.Dual(), &b)
 	return z
 }
 
 // Dil sets z equal to the dilation of y by a, and returns z.
 //
 // This is a special case of Mul:
-// 		Dil(y, a) = Mul(y, Hyper{Real{a, 0}, 0})
+// 		Dil(y, a) = Mul(y, Hyper{a, 0, 0, 0})
 func (z *Hyper) Dil(y *Hyper, a float64) *Hyper {
-	z[0] = new(Real).Scal(y[0], a)
-	z[1] = new(Real).Scal(y[1], a)
+	for i := range z {
+		z[i] = y[i] * a
+	}
 	return z
 }
 
-// Neg sets z equal to the negative of y, and returns z.
+// Neg sets z equal to the negative of y, and returns z. It flips the sign of
+// each component, zeros included.
 func (z *Hyper) Neg(y *Hyper) *Hyper {
-	return z.Dil(y, -1)
+	for i := range z {
+		z[i] = -y[i]
+	}
+	return z
 }
 
 // Conj sets z equal to the conjugate of y, and returns z.
 func (z *Hyper) Conj(y *Hyper) *Hyper {
-	z[0] = new(Real).Conj(y[0])
-	z[1] = new(Real).Neg(y[1])
+	z.Real().Conj(y.Real())
+	z.Dual().Neg(y.Dual())
 	return z
 }
 
 // Add sets z equal to the sum of x and y, and returns z.
 func (z *Hyper) Add(x, y *Hyper) *Hyper {
-	z[0] = new(Real).Add(x[0], y[0])
-	z[1] = new(Real).Add(x[1], y[1])
+	for i := range z {
+		z[i] = x[i] + y[i]
+	}
 	return z
 }
 
 // Sub sets z equal to the difference of x and y, and returns z.
 func (z *Hyper) Sub(x, y *Hyper) *Hyper {
-	z[0] = new(Real).Sub(x[0], y[0])
-	z[1] = new(Real).Sub(x[1], y[1])
+	for i := range z {
+		z[i] = x[i] - y[i]
+	}
 	return z
 }
 
@@ -163,12 +309,119 @@ func (z *Hyper) Sub(x, y *Hyper) *Hyper {
 //      η * εη = εη * η = 0
 // This multiplication rule is commutative and associative.
 func (z *Hyper) Mul(x, y *Hyper) *Hyper {
-	p := new(Hyper).Copy(x)
-	q := new(Hyper).Copy(y)
-	z[0] = new(Real).Mul(p[0], q[0])
-	z[1] = new(Real).Add(
-		new(Real).Mul(p[0], q[1]),
-		new(Real).Mul(p[1], q[0]),
-	)
+	p, q := *x, *y
+	var s, t Real
+	if *q.Dual() != (Real{}) || hasNaN(p.Real()[:]...) {
+		s.Mul(p.Real(), q.Dual())
+	}
+	if *p.Dual() != (Real{}) || hasNaN(q.Real()[:]...) {
+		t.Mul(p.Dual(), q.Real())
+	}
+	z.Real().Mul(p.Real(), q.Real())
+	z.Dual().Add(&s, &t)
+	return z
+}
+
+// MulAdd sets z equal to x*y + w, and returns z. The products of the Real
+// parts are accumulated with Real.MulAdd.
+func (z *Hyper) MulAdd(x, y, w *Hyper) *Hyper {
+	p, q, v := *x, *y, *w
+	z.Real().MulAdd(p.Real(), q.Real(), v.Real())
+	z.SetDual(v.Dual())
+	if *q.Dual() != (Real{}) || hasNaN(p.Real()[:]...) {
+		z.Dual().MulAdd(p.Real(), q.Dual(), z.Dual())
+	}
+	if *p.Dual() != (Real{}) || hasNaN(q.Real()[:]...) {
+		z.Dual().MulAdd(p.Dual(), q.Real(), z.Dual())
+	}
+	return z
+}
+
+// Quad returns the quadrance of z, a float64 value.
+func (z *Hyper) Quad() float64 {
+	a := z.Real().Real()
+	return a * a
+}
+
+// IsZeroDiv returns true if z is a zero divisor. This is equivalent to
+// z being nilpotent (i.e. z³ = 0).
+func (z *Hyper) IsZeroDiv() bool {
+	return z.Real().IsZeroDiv()
+}
+
+// Cond returns a condition estimate for division by z: the ratio of the
+// Euclidean norm of all four components of z to the magnitude of its first
+// component, which alone decides whether z is a zero divisor. As with
+// Real.Cond, it is +Inf for a zero first component.
+func (z *Hyper) Cond() float64 {
+	return condition(z[:], 1)
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
+// then Inv panics.
+//
+// If y = p + qη, with p and q Real values, then the inverse is
+// 		1/p - (q/p²)η
+// since η² = 0.
+func (z *Hyper) Inv(y *Hyper) *Hyper {
+	if y.IsZeroDiv() {
+		panic("zero divisor")
+	}
+	var p, q Real
+	p.Inv(y.Real())
+	// Multiplying by p twice, rather than by p², keeps q from underflowing
+	// or overflowing when q/p² is representable.
+	q.Mul(q.Mul(y.Dual(), &p), &p)
+	z.Real().Copy(&p)
+	z.Dual().Neg(&q)
 	return z
 }
+
+// Quo sets z equal to the quotient of x and y, and returns z. If y is a zero
+// divisor, then Quo panics.
+func (z *Hyper) Quo(x, y *Hyper) *Hyper {
+	if y.IsZeroDiv() {
+		panic("zero divisor denominator")
+	}
+	return z.Mul(x, new(Hyper).Inv(y))
+}
+
+// InvChecked sets z equal to the inverse of y, and returns z and a nil error.
+// If y is a zero divisor, then z is left unchanged and ErrZeroDivisor is
+// returned in place of the panic of Inv.
+func (z *Hyper) InvChecked(y *Hyper) (*Hyper, error) {
+	if y.IsZeroDiv() {
+		return z, ErrZeroDivisor
+	}
+	return z.Inv(y), nil
+}
+
+// QuoChecked sets z equal to the quotient of x and y, and returns z and a nil
+// error. If y is a zero divisor, then z is left unchanged and ErrZeroDivisor is
+// returned in place of the panic of Quo.
+func (z *Hyper) QuoChecked(x, y *Hyper) (*Hyper, error) {
+	if y.IsZeroDiv() {
+		return z, ErrZeroDivisor
+	}
+	return z.Quo(x, y), nil
+}
+
+// InvNaN sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then every component of z is set to NaN in place of the panic of
+// Inv, so that the failure travels with the value, as with float64 division.
+func (z *Hyper) InvNaN(y *Hyper) *Hyper {
+	if y.IsZeroDiv() {
+		return z.Copy(HyperNaN())
+	}
+	return z.Inv(y)
+}
+
+// QuoNaN sets z equal to the quotient of x and y, and returns z. If y is a zero
+// divisor, then every component of z is set to NaN in place of the panic of
+// Quo.
+func (z *Hyper) QuoNaN(x, y *Hyper) *Hyper {
+	if y.IsZeroDiv() {
+		return z.Copy(HyperNaN())
+	}
+	return z.Quo(x, y)
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "testing"
+
+func TestHyperAccessors(t *testing.T) {
+	// f(x) = x³ at x = 2: f′ = 12 and f″ = 12.
+	x := NewHyper(2, 1, 1, 0)
+	z := new(Hyper).Mul(x, new(Hyper).Mul(x, x))
+	if z.Eps() != 12 || z.Eta() != 12 || z.Cross() != 12 {
+		t.Errorf("%v has ε, η, εη coefficients %v, %v, %v, want 12, 12, 12",
+			z, z.Eps(), z.Eta(), z.Cross())
+	}
+}
+
+func TestHyperQuo(t *testing.T) {
+	// With x = 2 + ε + η, 1/x gives f(2), f'(2), f'(2), and f''(2) for
+	// f(x) = 1/x.
+	z := new(Hyper).Inv(NewHyper(2, 1, 1, 0))
+	if want := NewHyper(0.5, -0.25, -0.25, 0.25); !z.Equals(want) {
+		t.Errorf("Inv = %v, want %v", z, want)
+	}
+	x, y := NewHyper(1, 2, 3, 4), NewHyper(5, 6, 7, 8)
+	if q := new(Hyper).Quo(new(Hyper).Mul(x, y), y); !q.Equals(x) {
+		t.Errorf("Quo(Mul(x, y), y) = %v, want %v", q, x)
+	}
+	if _, err := new(Hyper).InvChecked(NewHyper(0, 1, 2, 3)); err != ErrZeroDivisor {
+		t.Errorf("InvChecked of zero divisor: err = %v", err)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+
+	"github.com/meirizarrygelpi/quat"
+)
+
+// A Normalization is a policy for keeping a pose a unit dual quaternion while it
+// is integrated, against the drift of rounding errors.
+type Normalization int
+
+const (
+	// NormalizeNone leaves the pose as computed.
+	NormalizeNone Normalization = iota
+	// NormalizeReal divides the pose by the norm of its real part.
+	NormalizeReal
+	// NormalizeFull divides the pose by the norm of its real part, and then
+	// removes the part of its dual part along the real part, so that both
+	// conditions of a unit dual quaternion hold.
+	NormalizeFull
+)
+
+// Integrate sets z equal to the pose q advanced for a time dt with the constant
+// twist x, with the normalization n applied to the result, and returns z. The
+// pose is a unit dual quaternion r + εd, with d = ½tr, as in Line.Transform,
+// and the twist is measured in the fixed frame. The result is
+// 		exp(½ dt x) q
+// with the product of dual quaternions (r₁ + εd₁)(r₂ + εd₂) = r₁r₂ + ε(r₁d₂ +
+// d₁r₂). The exponential is exact, so a constant twist gives the same pose
+// whether it is integrated in one step or in many.
+func (z *Hamilton) Integrate(q *Hamilton, x *Twist, dt float64, n Normalization) *Hamilton {
+	e := expTwist(scale3(x.Angular(), dt/2), scale3(x.Linear(), dt/2))
+	p := compose(&e, q)
+	p.normalize(n)
+	return z.Copy(&p)
+}
+
+// normalize applies the normalization n to the pose z.
+func (z *Hamilton) normalize(n Normalization) {
+	r, d := &z[0], &z[1]
+	switch n {
+	case NormalizeReal, NormalizeFull:
+		a := 1 / math.Sqrt(r.Quad())
+		r.Dil(r, a)
+		d.Dil(d, a)
+		if n == NormalizeFull {
+			d.Sub(d, new(quat.Hamilton).Dil(r, dotQuat(r, d)))
+		}
+	}
+}
+
+// compose returns the product of the dual quaternions x and y as rigid motions,
+// the motion y followed by the motion x:
+// 		(r₁ + εd₁)(r₂ + εd₂) = r₁r₂ + ε(r₁d₂ + d₁r₂)
+// This is not the product of Mul, whose dual part multiplies in a different
+// order and conjugates.
+func compose(x, y *Hamilton) Hamilton {
+	d := mulQuat(&x[0], &y[1])
+	e := mulQuat(&x[1], &y[0])
+	return Hamilton{mulQuat(&x[0], &y[0]), *d.Add(&d, &e)}
+}
+
+// inverseMotion returns the inverse r* + εd* of the unit dual quaternion x.
+func inverseMotion(x *Hamilton) Hamilton {
+	var z Hamilton
+	z[0].Conj(&x[0])
+	z[1].Conj(&x[1])
+	return z
+}
+
+// pure returns the pure dual quaternion ω + εv.
+func pure(ω, v [3]float64) Hamilton {
+	return Hamilton{
+		quat.Hamilton{complex(0, ω[0]), complex(ω[1], ω[2])},
+		quat.Hamilton{complex(0, v[0]), complex(v[1], v[2])},
+	}
+}
+
+// sinc returns sin θ/θ and (cos θ - sin θ/θ)/θ², replaced by their Taylor
+// series near θ = 0.
+func sinc(θ float64) (k, g float64) {
+	if θ < 1e-4 {
+		return 1 - (θ * θ / 6), (θ * θ / 30) - (1.0 / 3)
+	}
+	k = math.Sin(θ) / θ
+	return k, (math.Cos(θ) - k) / (θ * θ)
+}
+
+// expTwist returns the exponential of the pure dual quaternion ω + εv, a unit
+// dual quaternion:
+// 		exp(ω + εv) = cos θ + (sin θ/θ)ω + ε(-(ω·v)sin θ/θ + (sin θ/θ)v +
+// 		((ω·v)(cos θ - sin θ/θ)/θ²)ω)
+// with θ = |ω|.
+func expTwist(ω, v [3]float64) Hamilton {
+	θ := norm3(ω)
+	k, g := sinc(θ)
+	p := dot3(ω, v)
+	z := pure(scale3(ω, k), add3(scale3(v, k), scale3(ω, p*g)))
+	z[0][0] += complex(math.Cos(θ), 0)
+	z[1][0] += complex(-p*k, 0)
+	return z
+}
+
+// logMotion returns the pure dual quaternion ω + εv whose exponential is the
+// unit dual quaternion x, with |ω| at most π/2, so that the motion is along the
+// shortest path. It inverts expTwist.
+func logMotion(x *Hamilton) (ω, v [3]float64) {
+	y := *x
+	if real(y[0][0]) < 0 {
+		y.Neg(&y)
+	}
+	u, f := y.VectorPart()
+	s := norm3(u)
+	θ := math.Atan2(s, real(y[0][0]))
+	k, g := sinc(θ)
+	ω = scale3(u, 1/k)
+	p := -real(y[1][0]) / k
+	v = scale3(sub3(f, scale3(ω, p*g)), 1/k)
+	return ω, v
+}
+
+// dotQuat returns the Euclidean dot product of the quaternions x and y, seen
+// as 4-vectors.
+func dotQuat(x, y *quat.Hamilton) float64 {
+	return (real(x[0]) * real(y[0])) + (imag(x[0]) * imag(y[0])) +
+		(real(x[1]) * real(y[1])) + (imag(x[1]) * imag(y[1]))
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIntegrate(t *testing.T) {
+	const tol = 1e-12
+	z := [3]float64{0, 0, 1}
+	tests := []struct {
+		x    *Twist
+		time float64
+		want *Hamilton
+	}{
+		// A screw along the z axis, with pitch 2.
+		{NewTwist(z, [3]float64{0, 0, 2}), 1.5, motion(z, 1.5, [3]float64{0, 0, 3})},
+		// Half a turn about the axis through (1, 0, 0), whose velocity at the
+		// origin is -ω × p.
+		{NewTwist(z, [3]float64{0, -1, 0}), math.Pi, motion(z, math.Pi, [3]float64{2, 0, 0})},
+		// A pure translation.
+		{NewTwist([3]float64{}, [3]float64{1, 2, 3}), 2, motion(z, 0, [3]float64{2, 4, 6})},
+	}
+	for _, test := range tests {
+		one := new(Hamilton).Integrate(NewHamilton(1, 0, 0, 0, 0, 0, 0, 0), test.x, test.time, NormalizeNone)
+		if !one.EqualsTol(test.want, tol) {
+			t.Errorf("one step of %v = %v, want %v", test.x, one, test.want)
+		}
+		q := NewHamilton(1, 0, 0, 0, 0, 0, 0, 0)
+		for i := 0; i < 100; i++ {
+			q.Integrate(q, test.x, test.time/100, NormalizeFull)
+		}
+		if !q.EqualsTol(test.want, tol) {
+			t.Errorf("100 steps of %v = %v, want %v", test.x, q, test.want)
+		}
+	}
+	// A pose that has drifted from unit length.
+	q := NewHamilton(2, 0, 0, 0, 0.5, 1, 0, 0)
+	x := NewTwist([3]float64{0.1, 0.2, 0.3}, [3]float64{1, 0, 0})
+	if got := new(Hamilton).Integrate(q, x, 0.01, NormalizeNone); math.Abs(got.Quad()-4) > tol {
+		t.Errorf("NormalizeNone changed the quadrance to %v", got.Quad())
+	}
+	if got := new(Hamilton).Integrate(q, x, 0.01, NormalizeReal); math.Abs(got.Quad()-1) > tol {
+		t.Errorf("NormalizeReal gave quadrance %v", got.Quad())
+	}
+	got := new(Hamilton).Integrate(q, x, 0.01, NormalizeFull)
+	if d := dotQuat(got.Real(), got.Dual()); math.Abs(got.Quad()-1) > tol || math.Abs(d) > tol {
+		t.Errorf("NormalizeFull gave quadrance %v and r·d = %v", got.Quad(), d)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "iter"
+
+// seq returns an iterator over the elements of v.
+func seq(v []float64) iter.Seq[float64] {
+	return func(yield func(float64) bool) {
+		for _, x := range v {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// Components returns an iterator over the two components of z, in the order
+// of Cartesian.
+func (z *Real) Components() iter.Seq[float64] {
+	return seq(z[:])
+}
+
+// Components returns an iterator over the four components of z, in the order
+// of Cartesian.
+func (z *Complex) Components() iter.Seq[float64] {
+	return seq(z.components())
+}
+
+// Components returns an iterator over the eight components of z, in the order
+// of Cartesian.
+func (z *Hamilton) Components() iter.Seq[float64] {
+	return seq(z.components())
+}
+
+// Components returns an iterator over the four components of z, in the order
+// of Cartesian.
+func (z *Perplex) Components() iter.Seq[float64] {
+	return seq(z.components())
+}
+
+// Components returns an iterator over the four components of z, in the order
+// of Cartesian.
+func (z *Super) Components() iter.Seq[float64] {
+	return seq(z[:])
+}
+
+// Components returns an iterator over the four components of z, in the order
+// of Cartesian.
+func (z *Hyper) Components() iter.Seq[float64] {
+	return seq(z[:])
+}
+
+// Components returns an iterator over the eight components of z, in the order
+// of Cartesian.
+func (z *Ultra) Components() iter.Seq[float64] {
+	return seq(z[:])
+}
+
+// All returns an iterator over the indices and the elements of v. The elements
+// are pointers into v, so setting them sets v.
+func (v DualVector) All() iter.Seq2[int, *Real] {
+	return func(yield func(int, *Real) bool) {
+		for i := range v {
+			if !yield(i, &v[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of v, as pointers into v.
+func (v DualVector) Values() iter.Seq[*Real] {
+	return func(yield func(*Real) bool) {
+		for i := range v {
+			if !yield(&v[i]) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indices and the elements of z. Each element
+// is a new Real value, as from At; use SetAt to set z.
+func (z DualSlice) All() iter.Seq2[int, *Real] {
+	return func(yield func(int, *Real) bool) {
+		for i := range z.Real {
+			if !yield(i, z.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of z, each one a new Real value,
+// as from At.
+func (z DualSlice) Values() iter.Seq[*Real] {
+	return func(yield func(*Real) bool) {
+		for i := range z.Real {
+			if !yield(z.At(i)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestComponents(t *testing.T) {
+	if got := slices.Collect(NewReal(1, 2).Components()); !slices.Equal(got, []float64{1, 2}) {
+		t.Errorf("Real components = %v", got)
+	}
+	h := NewHamilton(1, 2, 3, 4, 5, 6, 7, 8)
+	if got := slices.Collect(h.Components()); !slices.Equal(got, h.components()) {
+		t.Errorf("Hamilton components = %v", got)
+	}
+	if got := slices.Collect(NewPerplex(1, 2, 3, 4).Components()); !slices.Equal(got, []float64{1, 2, 3, 4}) {
+		t.Errorf("Perplex components = %v", got)
+	}
+	// Breaking out of the loop stops the iterator.
+	n := 0
+	for range NewUltra(1, 2, 3, 4, 5, 6, 7, 8).Components() {
+		if n++; n == 3 {
+			break
+		}
+	}
+	if n != 3 {
+		t.Errorf("visited %d components, want 3", n)
+	}
+}
+
+func TestVectorIter(t *testing.T) {
+	v := DualVector{*NewReal(1, 2), *NewReal(3, 4)}
+	for i, x := range v.All() {
+		x.Scal(x, float64(i+1))
+	}
+	if want := (DualVector{*NewReal(1, 2), *NewReal(6, 8)}); !v.Equals(want) {
+		t.Errorf("scaled vector = %v, want %v", v, want)
+	}
+	s := NewDualSlice(2).FromVector(v)
+	var got DualVector
+	for x := range s.Values() {
+		got = append(got, *x)
+	}
+	if !got.Equals(v) {
+		t.Errorf("slice values = %v, want %v", got, v)
+	}
+	for i, x := range s.All() {
+		if !x.Equals(&v[i]) {
+			t.Errorf("element %d = %v, want %v", i, x, &v[i])
+		}
+	}
+}
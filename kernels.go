@@ -0,0 +1,62 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+// The loops of the DualSlice methods and HamiltonBatchMul call the kernels
+// below through function variables. They start out as the portable Go versions, and an init function
+// for the architecture may replace them with assembly versions that give the
+// same results bit for bit, including for infinite and NaN values. Every slice
+// passed to a kernel has the length of z, or zr, and an output may alias the
+// input at the same index.
+var (
+	addFloats = addFloatsGo
+	subFloats = subFloatsGo
+	mulDual   = mulDualGo
+	scaleDual = scaleDualGo
+
+	mulHamiltons = mulHamiltonsGo
+)
+
+// addFloatsGo sets z[i] = x[i] + y[i] for each index i of z.
+func addFloatsGo(z, x, y []float64) {
+	for i := range z {
+		z[i] = x[i] + y[i]
+	}
+}
+
+// subFloatsGo sets z[i] = x[i] - y[i] for each index i of z.
+func subFloatsGo(z, x, y []float64) {
+	for i := range z {
+		z[i] = x[i] - y[i]
+	}
+}
+
+// mulDualGo sets each element of zr + zdε equal to the product of the matching
+// elements of xr + xdε and yr + ydε, computed as in Real.Mul.
+func mulDualGo(zr, zd, xr, xd, yr, yd []float64) {
+	for i := range zr {
+		a, b := xr[i], xd[i]
+		c, d := yr[i], yd[i]
+		zr[i] = a * c
+		zd[i] = mulDualPart(a, b, c, d)
+	}
+}
+
+// scaleDualGo sets each element of zr + zdε equal to the matching element of
+// yr + ydε multiplied by c + dε, computed as in Real.Mul.
+func scaleDualGo(zr, zd, yr, yd []float64, c, d float64) {
+	for i := range zr {
+		p, q := yr[i], yd[i]
+		zr[i] = p * c
+		zd[i] = mulDualPart(p, q, c, d)
+	}
+}
+
+// mulHamiltonsGo sets z[i] equal to the product of x[i] and y[i] for each index
+// i of z, computed by Hamilton.Mul.
+func mulHamiltonsGo(z, x, y []Hamilton) {
+	for i := range z {
+		z[i].Mul(&x[i], &y[i])
+	}
+}
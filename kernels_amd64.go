@@ -0,0 +1,95 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+//go:build !purego
+
+package dual
+
+// The AVX2 kernels work on four float64 values at a time, and use FMA for the
+// fused dual parts of products, so they need both extensions. Building with
+// the purego tag leaves out the assembly.
+
+//go:noescape
+func addFloatsAVX2(z, x, y []float64)
+
+//go:noescape
+func subFloatsAVX2(z, x, y []float64)
+
+// mulDualAVX2 and scaleDualAVX2 stop before the first block of four elements,
+// or the first single element of the tail, whose dual part is NaN, and return
+// the number of elements done. The wrappers mulDualAsm and scaleDualAsm finish
+// that block with the Go kernel, so NaN dual parts get the crossTerm fallback
+// of Real.Mul, and then resume.
+
+//go:noescape
+func mulDualAVX2(zr, zd, xr, xd, yr, yd []float64) int
+
+//go:noescape
+func scaleDualAVX2(zr, zd, yr, yd []float64, c, d float64) int
+
+// mulDualAsm is mulDualGo with the bulk of the work done by mulDualAVX2.
+func mulDualAsm(zr, zd, xr, xd, yr, yd []float64) {
+	for {
+		n := mulDualAVX2(zr, zd, xr, xd, yr, yd)
+		if n == len(zr) {
+			return
+		}
+		m := min(n+4, len(zr))
+		mulDualGo(zr[n:m], zd[n:m], xr[n:m], xd[n:m], yr[n:m], yd[n:m])
+		zr, zd, xr, xd, yr, yd = zr[m:], zd[m:], xr[m:], xd[m:], yr[m:], yd[m:]
+	}
+}
+
+// scaleDualAsm is scaleDualGo with the bulk of the work done by
+// scaleDualAVX2.
+func scaleDualAsm(zr, zd, yr, yd []float64, c, d float64) {
+	for {
+		n := scaleDualAVX2(zr, zd, yr, yd, c, d)
+		if n == len(zr) {
+			return
+		}
+		m := min(n+4, len(zr))
+		scaleDualGo(zr[n:m], zd[n:m], yr[n:m], yd[n:m], c, d)
+		zr, zd, yr, yd = zr[m:], zd[m:], yr[m:], yd[m:]
+	}
+}
+
+// cpuid returns the registers set by the CPUID instruction for the leaf eaxArg
+// and subleaf ecxArg.
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+// xgetbv returns the low 32 bits of the XCR0 register.
+func xgetbv() uint32
+
+// hasAVX2 reports whether the CPU supports AVX2 and FMA, and the operating
+// system saves the YMM registers.
+func hasAVX2() bool {
+	maxLeaf, _, _, _ := cpuid(0, 0)
+	if maxLeaf < 7 {
+		return false
+	}
+	_, _, ecx1, _ := cpuid(1, 0)
+	const (
+		fma     = 1 << 12
+		osxsave = 1 << 27
+		avx     = 1 << 28
+	)
+	if ecx1&(fma|osxsave|avx) != fma|osxsave|avx {
+		return false
+	}
+	// The XMM and YMM state must both be enabled.
+	if xgetbv()&6 != 6 {
+		return false
+	}
+	_, ebx7, _, _ := cpuid(7, 0)
+	return ebx7&(1<<5) != 0
+}
+
+func init() {
+	if hasAVX2() {
+		addFloats = addFloatsAVX2
+		subFloats = subFloatsAVX2
+		mulDual = mulDualAsm
+		scaleDual = scaleDualAsm
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+//go:build !purego
+
+#include "textflag.h"
+
+// func addFloatsAVX2(z, x, y []float64)
+TEXT ·addFloatsAVX2(SB), NOSPLIT, $0-72
+	MOVQ z_base+0(FP), DI
+	MOVQ z_len+8(FP), CX
+	MOVQ x_base+24(FP), SI
+	MOVQ y_base+48(FP), DX
+	XORQ AX, AX
+	MOVQ CX, BX
+	ANDQ $~3, BX
+
+add4:
+	CMPQ AX, BX
+	JGE  add1
+	VMOVUPD (SI)(AX*8), Y0
+	VADDPD  (DX)(AX*8), Y0, Y0
+	VMOVUPD Y0, (DI)(AX*8)
+	ADDQ    $4, AX
+	JMP     add4
+
+add1:
+	CMPQ  AX, CX
+	JGE   addDone
+	MOVSD (SI)(AX*8), X0
+	ADDSD (DX)(AX*8), X0
+	MOVSD X0, (DI)(AX*8)
+	INCQ  AX
+	JMP   add1
+
+addDone:
+	VZEROUPPER
+	RET
+
+// func subFloatsAVX2(z, x, y []float64)
+TEXT ·subFloatsAVX2(SB), NOSPLIT, $0-72
+	MOVQ z_base+0(FP), DI
+	MOVQ z_len+8(FP), CX
+	MOVQ x_base+24(FP), SI
+	MOVQ y_base+48(FP), DX
+	XORQ AX, AX
+	MOVQ CX, BX
+	ANDQ $~3, BX
+
+sub4:
+	CMPQ AX, BX
+	JGE  sub1
+	VMOVUPD (SI)(AX*8), Y0
+	VSUBPD  (DX)(AX*8), Y0, Y0
+	VMOVUPD Y0, (DI)(AX*8)
+	ADDQ    $4, AX
+	JMP     sub4
+
+sub1:
+	CMPQ  AX, CX
+	JGE   subDone
+	MOVSD (SI)(AX*8), X0
+	SUBSD (DX)(AX*8), X0
+	MOVSD X0, (DI)(AX*8)
+	INCQ  AX
+	JMP   sub1
+
+subDone:
+	VZEROUPPER
+	RET
+
+// func mulDualAVX2(zr, zd, xr, xd, yr, yd []float64) int
+//
+// For a = xr, b = xd, c = yr, d = yd: zr = a·c and zd = fma(a, d, b·c).
+// Every input is loaded before either output is stored, so the outputs may
+// alias the inputs. A NaN dual part stops the loop before anything of its
+// block is stored.
+TEXT ·mulDualAVX2(SB), NOSPLIT, $0-152
+	MOVQ zr_base+0(FP), DI
+	MOVQ zr_len+8(FP), CX
+	MOVQ zd_base+24(FP), R8
+	MOVQ xr_base+48(FP), SI
+	MOVQ xd_base+72(FP), R9
+	MOVQ yr_base+96(FP), DX
+	MOVQ yd_base+120(FP), R10
+	XORQ AX, AX
+	MOVQ CX, BX
+	ANDQ $~3, BX
+
+mul4:
+	CMPQ AX, BX
+	JGE  mul1
+	VMOVUPD     (SI)(AX*8), Y0
+	VMOVUPD     (R9)(AX*8), Y1
+	VMOVUPD     (DX)(AX*8), Y2
+	VMOVUPD     (R10)(AX*8), Y3
+	VMULPD      Y2, Y1, Y4
+	VFMADD231PD Y3, Y0, Y4
+	VCMPPD      $3, Y4, Y4, Y5
+	VMOVMSKPD   Y5, R11
+	TESTQ       R11, R11
+	JNZ         mulStop
+	VMULPD      Y2, Y0, Y0
+	VMOVUPD     Y0, (DI)(AX*8)
+	VMOVUPD     Y4, (R8)(AX*8)
+	ADDQ        $4, AX
+	JMP         mul4
+
+mul1:
+	CMPQ        AX, CX
+	JGE         mulStop
+	MOVSD       (SI)(AX*8), X0
+	MOVSD       (R9)(AX*8), X1
+	MOVSD       (DX)(AX*8), X2
+	MOVSD       (R10)(AX*8), X3
+	VMULSD      X2, X1, X4
+	VFMADD231SD X3, X0, X4
+	UCOMISD     X4, X4
+	JPS         mulStop
+	VMULSD      X2, X0, X0
+	MOVSD       X0, (DI)(AX*8)
+	MOVSD       X4, (R8)(AX*8)
+	INCQ        AX
+	JMP         mul1
+
+mulStop:
+	MOVQ AX, ret+144(FP)
+	VZEROUPPER
+	RET
+
+// func scaleDualAVX2(zr, zd, yr, yd []float64, c, d float64) int
+//
+// For p = yr and q = yd: zr = p·c and zd = fma(p, d, q·c). A NaN dual part
+// stops the loop as in mulDualAVX2.
+TEXT ·scaleDualAVX2(SB), NOSPLIT, $0-120
+	MOVQ zr_base+0(FP), DI
+	MOVQ zr_len+8(FP), CX
+	MOVQ zd_base+24(FP), R8
+	MOVQ yr_base+48(FP), SI
+	MOVQ yd_base+72(FP), R9
+	VBROADCASTSD c+96(FP), Y2
+	VBROADCASTSD d+104(FP), Y3
+	XORQ AX, AX
+	MOVQ CX, BX
+	ANDQ $~3, BX
+
+scale4:
+	CMPQ AX, BX
+	JGE  scale1
+	VMOVUPD     (SI)(AX*8), Y0
+	VMOVUPD     (R9)(AX*8), Y1
+	VMULPD      Y2, Y1, Y4
+	VFMADD231PD Y3, Y0, Y4
+	VCMPPD      $3, Y4, Y4, Y5
+	VMOVMSKPD   Y5, R11
+	TESTQ       R11, R11
+	JNZ         scaleStop
+	VMULPD      Y2, Y0, Y0
+	VMOVUPD     Y0, (DI)(AX*8)
+	VMOVUPD     Y4, (R8)(AX*8)
+	ADDQ        $4, AX
+	JMP         scale4
+
+scale1:
+	CMPQ        AX, CX
+	JGE         scaleStop
+	MOVSD       (SI)(AX*8), X0
+	MOVSD       (R9)(AX*8), X1
+	VMULSD      X2, X1, X4
+	VFMADD231SD X3, X0, X4
+	UCOMISD     X4, X4
+	JPS         scaleStop
+	VMULSD      X2, X0, X0
+	MOVSD       X0, (DI)(AX*8)
+	MOVSD       X4, (R8)(AX*8)
+	INCQ        AX
+	JMP         scale1
+
+scaleStop:
+	MOVQ AX, ret+112(FP)
+	VZEROUPPER
+	RET
+
+// func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+TEXT ·cpuid(SB), NOSPLIT, $0-24
+	MOVL eaxArg+0(FP), AX
+	MOVL ecxArg+4(FP), CX
+	CPUID
+	MOVL AX, eax+8(FP)
+	MOVL BX, ebx+12(FP)
+	MOVL CX, ecx+16(FP)
+	MOVL DX, edx+20(FP)
+	RET
+
+// func xgetbv() uint32
+TEXT ·xgetbv(SB), NOSPLIT, $0-4
+	MOVL $0, CX
+	XGETBV
+	MOVL AX, ret+0(FP)
+	RET
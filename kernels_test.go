@@ -0,0 +1,119 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// floatsEqual reports whether x and y have the same bits at every index.
+func floatsEqual(x, y []float64) bool {
+	for i := range x {
+		if math.Float64bits(x[i]) != math.Float64bits(y[i]) {
+			return false
+		}
+	}
+	return len(x) == len(y)
+}
+
+func TestKernels(t *testing.T) {
+	// The selected kernels must match the Go kernels bit for bit, for every
+	// length around the vector width, and when the output aliases an input.
+	rnd := rand.New(rand.NewSource(1))
+	randFloats := func(n int) []float64 {
+		v := make([]float64, n)
+		for i := range v {
+			v[i] = rnd.NormFloat64() * math.Exp2(float64(rnd.Intn(40)-20))
+		}
+		return v
+	}
+	c, d := 1.0/3, -math.Pi
+	for n := 0; n <= 19; n++ {
+		xr, xd, yr, yd := randFloats(n), randFloats(n), randFloats(n), randFloats(n)
+		got, want := make([]float64, n), make([]float64, n)
+		addFloats(got, xr, yr)
+		addFloatsGo(want, xr, yr)
+		if !floatsEqual(got, want) {
+			t.Errorf("addFloats(n = %d) = %v, want %v", n, got, want)
+		}
+		subFloats(got, xr, yr)
+		subFloatsGo(want, xr, yr)
+		if !floatsEqual(got, want) {
+			t.Errorf("subFloats(n = %d) = %v, want %v", n, got, want)
+		}
+		gotD, wantD := make([]float64, n), make([]float64, n)
+		mulDual(got, gotD, xr, xd, yr, yd)
+		mulDualGo(want, wantD, xr, xd, yr, yd)
+		if !floatsEqual(got, want) || !floatsEqual(gotD, wantD) {
+			t.Errorf("mulDual(n = %d) = %v, %v, want %v, %v", n, got, gotD, want, wantD)
+		}
+		scaleDual(got, gotD, xr, xd, c, d)
+		scaleDualGo(want, wantD, xr, xd, c, d)
+		if !floatsEqual(got, want) || !floatsEqual(gotD, wantD) {
+			t.Errorf("scaleDual(n = %d) = %v, %v, want %v, %v", n, got, gotD, want, wantD)
+		}
+		// x = x·y in place.
+		mulDualGo(want, wantD, xr, xd, yr, yd)
+		mulDual(xr, xd, xr, xd, yr, yd)
+		if !floatsEqual(xr, want) || !floatsEqual(xd, wantD) {
+			t.Errorf("mulDual in place (n = %d) = %v, %v, want %v, %v", n, xr, xd, want, wantD)
+		}
+	}
+}
+
+func TestKernelsNonFinite(t *testing.T) {
+	// Products with infinite or NaN components take the crossTerm fallback of
+	// Real.Mul. Every pair of special values is tried, at each position of a
+	// block and of the tail.
+	special := []float64{0, math.Copysign(0, -1), 1, -2, math.Inf(1), math.Inf(-1), math.NaN()}
+	var xr, xd, yr, yd []float64
+	for _, a := range special {
+		for _, b := range special {
+			for _, c := range special {
+				for _, d := range []float64{0, 1, math.Inf(1), math.NaN()} {
+					xr, xd = append(xr, a), append(xd, b)
+					yr, yd = append(yr, c), append(yd, d)
+				}
+			}
+		}
+	}
+	n := len(xr) - 3 // leave a tail
+	got, gotD := make([]float64, n), make([]float64, n)
+	want, wantD := make([]float64, n), make([]float64, n)
+	mulDual(got, gotD, xr[:n], xd[:n], yr[:n], yd[:n])
+	mulDualGo(want, wantD, xr[:n], xd[:n], yr[:n], yd[:n])
+	if !floatsEqual(got, want) || !floatsEqual(gotD, wantD) {
+		t.Errorf("mulDual with non-finite values does not match mulDualGo")
+	}
+	for i := 0; i < n; i++ {
+		z := new(Real).Mul(&Real{xr[i], xd[i]}, &Real{yr[i], yd[i]})
+		if !floatsEqual(z[:], []float64{got[i], gotD[i]}) {
+			t.Errorf("mulDual[%d] = (%v, %v), want Real.Mul = %v", i, got[i], gotD[i], z)
+		}
+	}
+	for _, a := range special {
+		for _, b := range []float64{0, 1, math.Inf(-1), math.NaN()} {
+			scaleDual(got, gotD, xr[:n], xd[:n], a, b)
+			scaleDualGo(want, wantD, xr[:n], xd[:n], a, b)
+			if !floatsEqual(got, want) || !floatsEqual(gotD, wantD) {
+				t.Errorf("scaleDual by (%v, %v) does not match scaleDualGo", a, b)
+			}
+		}
+	}
+}
+
+func BenchmarkDualSliceAdd(b *testing.B) {
+	const n = 1024
+	x, y, z := NewDualSlice(n), NewDualSlice(n), NewDualSlice(n)
+	for i := 0; i < n; i++ {
+		x.Real[i], x.Dual[i] = float64(i), 1
+		y.Real[i], y.Dual[i] = 2, float64(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		z.Add(x, y)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package kinematics
+
+import "github.com/meirizarrygelpi/dual"
+
+// A FourBar is a planar four-bar linkage. The crank turns about the origin and
+// the rocker about the point (Ground, 0), and the coupler joins the free ends
+// of the crank and the rocker. The angles of the crank and the rocker are
+// measured counterclockwise from the positive x axis.
+type FourBar struct {
+	Ground, Crank, Coupler, Rocker float64
+}
+
+// Output returns the rocker angle of l for the crank angle θ in the assembly
+// asm, or ErrAssembly. The dual part of θ is carried to the output, so with
+// θ = θ₀ + ε the dual part of the output is the angular velocity ratio of the
+// rocker to the crank.
+//
+// With P the vector from the crank end to the rocker pivot, the loop closes
+// when
+// 		Pₓ cos φ + Pᵧ sin φ = (c² - d² - |P|²)/(2d)
+// for the coupler length c and the rocker length d.
+func (l *FourBar) Output(θ *dual.Real, asm Assembly) (*dual.Real, error) {
+	c, s := new(dual.Real).Cos(θ), new(dual.Real).Sin(θ)
+	px := dual.NewReal(l.Ground, 0)
+	px.Sub(px, mul(dual.NewReal(l.Crank, 0), c))
+	py := new(dual.Real).Neg(mul(dual.NewReal(l.Crank, 0), s))
+	k := dual.NewReal((l.Coupler*l.Coupler)-(l.Rocker*l.Rocker), 0)
+	k.Sub(k, mul(px, px))
+	k.Sub(k, mul(py, py))
+	k.Quo(k, dual.NewReal(2*l.Rocker, 0))
+	φ, err := solve(px, py, k, asm)
+	if err != nil {
+		return nil, err
+	}
+	return dual.NewReal(φ.Angle(), φ.Distance()), nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+// Package kinematics solves the loop-closure equations of simple linkages with
+// the dual numbers of package dual.
+//
+// The planar FourBar takes its input angle as a dual.Real value θ + θ'ε, so
+// that the output carries its derivative along, as in automatic
+// differentiation. The spatial RCCC linkage follows from the spherical four-bar
+// by the principle of transference: each angle of the spherical equation is
+// replaced with the dual angle θ + dε, which adds the distance or slide d along
+// the matching axis, and each trigonometric function with its dual version.
+//
+// Both equations reduce to the form
+// 		A cos φ + B sin φ = C
+// whose two solutions, one for each Assembly of the linkage, are
+// 		φ = atan2(B, A) ± atan2(√(A² + B² - C²), C)
+// If A² + B² < C², the linkage cannot be assembled at the given input.
+package kinematics
+
+import (
+	"errors"
+
+	"github.com/meirizarrygelpi/dual"
+)
+
+// An Assembly selects one of the two ways of closing a linkage loop.
+type Assembly int
+
+const (
+	// Open is the assembly with the plus sign in the solution for φ.
+	Open Assembly = 1
+	// Crossed is the assembly with the minus sign in the solution for φ.
+	Crossed Assembly = -1
+)
+
+// ErrAssembly is returned when a linkage cannot be closed at a given input.
+var ErrAssembly = errors.New("kinematics: the linkage cannot be assembled")
+
+// solve returns the solution of a cos φ + b sin φ = c for the assembly asm.
+func solve(a, b, c *dual.Real, asm Assembly) (*dual.DualAngle, error) {
+	d := new(dual.Real).Mul(a, a)
+	d.Add(d, new(dual.Real).Mul(b, b))
+	d.Sub(d, new(dual.Real).Mul(c, c))
+	if d.Real() < 0 {
+		return nil, ErrAssembly
+	}
+	s := new(dual.Real).Sqrt(d)
+	if asm == Crossed {
+		s.Neg(s)
+	}
+	φ := dual.DualAngleAtan2(b, a)
+	return φ.Add(φ, dual.DualAngleAtan2(s, c)), nil
+}
+
+// mul returns the product of the values x.
+func mul(x ...*dual.Real) *dual.Real {
+	z := dual.NewReal(1, 0)
+	for _, y := range x {
+		z.Mul(z, y)
+	}
+	return z
+}
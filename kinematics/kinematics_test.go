@@ -0,0 +1,88 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package kinematics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/meirizarrygelpi/dual"
+)
+
+const tol = 1e-9
+
+func TestFourBar(t *testing.T) {
+	l := &FourBar{Ground: 4, Crank: 1, Coupler: 3.5, Rocker: 3}
+	for _, asm := range []Assembly{Open, Crossed} {
+		for θ := 0.0; θ < 2*math.Pi; θ += 0.5 {
+			φ, err := l.Output(dual.NewReal(θ, 1), asm)
+			if err != nil {
+				t.Fatalf("Output(%v) = %v", θ, err)
+			}
+			ax, ay := l.Crank*math.Cos(θ), l.Crank*math.Sin(θ)
+			bx, by := l.Ground+l.Rocker*math.Cos(φ.Real()), l.Rocker*math.Sin(φ.Real())
+			if d := math.Hypot(bx-ax, by-ay); math.Abs(d-l.Coupler) > tol {
+				t.Errorf("coupler length at θ = %v is %v, want %v", θ, d, l.Coupler)
+			}
+			const h = 1e-6
+			p, _ := l.Output(dual.NewReal(θ+h, 0), asm)
+			q, _ := l.Output(dual.NewReal(θ-h, 0), asm)
+			if fd := (p.Real() - q.Real()) / (2 * h); math.Abs(fd-φ.Dual()) > 1e-6 {
+				t.Errorf("velocity ratio at θ = %v is %v, want %v", θ, φ.Dual(), fd)
+			}
+		}
+	}
+	if _, err := (&FourBar{Ground: 10, Crank: 1, Coupler: 2, Rocker: 2}).Output(dual.NewReal(0, 1), Open); err != ErrAssembly {
+		t.Errorf("err = %v, want ErrAssembly", err)
+	}
+}
+
+func TestRCCC(t *testing.T) {
+	// A crank-rocker: the twist angles, in degrees, are 20, 70, 50, and 60.
+	deg := math.Pi / 180
+	l := &RCCC{
+		Input:    *dual.NewDualAngle(20*deg, 0.2),
+		Coupler:  *dual.NewDualAngle(70*deg, 0.5),
+		Follower: *dual.NewDualAngle(50*deg, 0.3),
+		Ground:   *dual.NewDualAngle(60*deg, 0.4),
+	}
+	for _, asm := range []Assembly{Open, Crossed} {
+		for θ := 0.0; θ < 2*math.Pi; θ += 0.7 {
+			in := dual.NewDualAngle(θ, 0.3)
+			φ, err := l.Output(in, asm)
+			if err != nil {
+				t.Fatalf("Output(%v) = %v", in, err)
+			}
+			u1, u2, u3, u4 := l.Axes(in, φ)
+			for _, test := range []struct {
+				x, y *dual.Line
+				want dual.DualAngle
+			}{
+				{u1, u2, l.Input},
+				{u2, u3, l.Coupler},
+				{u3, u4, l.Follower},
+				{u1, u4, l.Ground},
+			} {
+				got := test.x.Angle(test.y)
+				if math.Abs(got.Angle()-test.want.Angle()) > tol ||
+					math.Abs(math.Abs(got.Distance())-test.want.Distance()) > tol {
+					t.Errorf("θ = %v: dual angle %v, want %v", θ, got, &test.want)
+				}
+			}
+		}
+	}
+}
+
+func TestRCCCAxes(t *testing.T) {
+	l := &RCCC{Input: *dual.NewDualAngle(1, 2)}
+	θ, s := 0.8, 1.5
+	_, u2, _, _ := l.Axes(dual.NewDualAngle(θ, s), new(dual.DualAngle))
+	_, v2, _, _ := l.Axes(new(dual.DualAngle), new(dual.DualAngle))
+	// The rotation by θ about the z axis followed by the slide s along it.
+	c, n := math.Cos(θ/2), math.Sin(θ/2)
+	q := dual.NewHamilton(c, 0, 0, n, -s*n/2, 0, 0, s*c/2)
+	if got := new(dual.Line).Transform(v2, q); !got.Equals(u2) {
+		t.Errorf("moved axis = %v, want %v", got, u2)
+	}
+}
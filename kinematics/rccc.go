@@ -0,0 +1,68 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package kinematics
+
+import "github.com/meirizarrygelpi/dual"
+
+// An RCCC is a spatial four-bar linkage with a revolute input joint and three
+// cylindric joints, the spatial analog of a spherical four-bar. Each link is
+// given by the dual angle α + aε between the axes of its two joints: the twist
+// angle α and the link length a along their common normal.
+//
+// The input axis is the z axis, and the output axis is at the dual angle Ground
+// from it, about the y axis. The input joint variable θ + sε is the rotation
+// and slide of the input link about the input axis, measured from the x axis.
+// The output joint variable φ + tε is the rotation and slide of the follower
+// link about the output axis, measured from the direction (cos α, 0, -sin α),
+// with α the twist angle of the ground link. A revolute input has s = 0.
+type RCCC struct {
+	Input, Coupler, Follower, Ground dual.DualAngle
+}
+
+// axes returns the unit dual vectors of the four joint axes of l for the input
+// θ and the output φ, in the order input, coupler-input, coupler-output, and
+// output.
+func (l *RCCC) axes(θ, φ *dual.DualAngle) (u1, u2, u3, u4 *dual.DualVec3) {
+	zero, one := dual.NewReal(0, 0), dual.NewReal(1, 0)
+	sa1, ca1 := l.Input.Sin(), l.Input.Cos()
+	sa3, ca3 := l.Follower.Sin(), l.Follower.Cos()
+	sa4, ca4 := l.Ground.Sin(), l.Ground.Cos()
+	sθ, cθ := θ.Sin(), θ.Cos()
+	sφ, cφ := φ.Sin(), φ.Cos()
+	u1 = &dual.DualVec3{*zero, *zero, *one}
+	u2 = &dual.DualVec3{*mul(sa1, cθ), *mul(sa1, sθ), *ca1}
+	u4 = &dual.DualVec3{*sa4, *zero, *ca4}
+	ea := &dual.DualVec3{*ca4, *zero, *new(dual.Real).Neg(sa4)}
+	eb := &dual.DualVec3{*zero, *one, *zero}
+	u3 = new(dual.DualVec3).Scale(ea, mul(sa3, cφ))
+	u3.Add(u3, new(dual.DualVec3).Scale(eb, mul(sa3, sφ)))
+	u3.Add(u3, new(dual.DualVec3).Scale(u4, ca3))
+	return u1, u2, u3, u4
+}
+
+// Axes returns the four joint axes of l for the input θ and the output φ, in
+// the order input, coupler-input, coupler-output, and output.
+func (l *RCCC) Axes(θ, φ *dual.DualAngle) (u1, u2, u3, u4 *dual.Line) {
+	v1, v2, v3, v4 := l.axes(θ, φ)
+	return (*dual.Line)(v1), (*dual.Line)(v2), (*dual.Line)(v3), (*dual.Line)(v4)
+}
+
+// Output returns the output joint variable of l for the input θ in the
+// assembly asm, or ErrAssembly. The loop closes when the coupler-input and
+// coupler-output axes are at the dual angle Coupler:
+// 		u₂ · u₃ = cos(Coupler)
+// which, with the output axis u₄ and the directions eₐ and eᵦ perpendicular to
+// it, is the equation
+// 		sin(α₃)(u₂ · eₐ) cos φ + sin(α₃)(u₂ · eᵦ) sin φ = cos(α₂) - cos(α₃)(u₂ · u₄)
+// in dual numbers.
+func (l *RCCC) Output(θ *dual.DualAngle, asm Assembly) (*dual.DualAngle, error) {
+	_, u2, _, u4 := l.axes(θ, new(dual.DualAngle))
+	sa3, ca3 := l.Follower.Sin(), l.Follower.Cos()
+	sa4, ca4 := l.Ground.Sin(), l.Ground.Cos()
+	ea := &dual.DualVec3{*ca4, *dual.NewReal(0, 0), *new(dual.Real).Neg(sa4)}
+	a := mul(sa3, u2.Dot(ea))
+	b := mul(sa3, &u2[1])
+	c := new(dual.Real).Sub(l.Coupler.Cos(), mul(ca3, u2.Dot(u4)))
+	return solve(a, b, c, asm)
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"strings"
+)
+
+// NewOrientedLine returns a pointer to the Complex value modeling the oriented
+// line x cos θ + y sin θ = p.
+//
+// The line has dual angle Θ = θ + pε, and it is modeled by the dual complex
+// number
+// 		cos(Θ/2) + sin(Θ/2)i
+// with the dual cosine and dual sine providing the real and dual parts of the
+// 1 and i components. These are homogeneous coordinates of the line on the
+// dual projective line, on which Laguerre transformations act as linear
+// fractional transformations.
+func NewOrientedLine(θ, p float64) *Complex {
+	h := NewDualAngle(θ/2, p/2)
+	return orientedLine(h.Cos(), h.Sin())
+}
+
+// OrientedLine returns the normal angle θ and the signed distance p of the
+// oriented line modeled by z. The angle is in the range (-π, π].
+func (z *Complex) OrientedLine() (θ, p float64) {
+	x0, x1 := z.homogeneous()
+	h := DualAngleAtan2(x1, x0)
+	θ = remainder2π(2 * h.Angle())
+	p = 2 * h.Distance()
+	return
+}
+
+// homogeneous returns the two dual homogeneous coordinates of the oriented
+// line modeled by z.
+func (z *Complex) homogeneous() (x0, x1 *Real) {
+	a, b, c, d := z.Cartesian()
+	x0 = NewReal(a, c)
+	x1 = NewReal(b, d)
+	return
+}
+
+// orientedLine returns a pointer to the Complex value modeling the oriented
+// line with dual homogeneous coordinates x0 and x1. The result is normalized
+// so that the normal angle is in the range (-π, π].
+func orientedLine(x0, x1 *Real) *Complex {
+	h := DualAngleAtan2(x1, x0)
+	θ := remainder2π(2 * h.Angle())
+	h = NewDualAngle(θ/2, h.Distance())
+	c, s := h.Cos(), h.Sin()
+	return new(Complex).Set(c.Real(), s.Real(), c.Dual(), s.Dual())
+}
+
+// remainder2π returns θ reduced to the range (-π, π].
+func remainder2π(θ float64) float64 {
+	θ = math.Remainder(θ, 2*math.Pi)
+	if θ <= -math.Pi {
+		θ += 2 * math.Pi
+	}
+	return θ
+}
+
+// A Laguerre represents a Laguerre transformation of oriented lines in the
+// plane, as an ordered array of four Real values a, b, c, d. It acts on the
+// dual tangent t = tan(Θ/2) of the dual angle of a line as:
+// 		t' = (at + b) / (ct + d)
+// Laguerre transformations map oriented lines to oriented lines and preserve
+// the tangential distance between oriented circles. They include the rigid
+// motions of the plane and the dilations that move every line by the same
+// distance along its normal.
+type Laguerre [4]Real
+
+// String returns the string version of a Laguerre value.
+//
+// If z has coefficients a, b, c, d, then the string is "[a b c d]", with each
+// coefficient printed as a Real value.
+func (z *Laguerre) String() string {
+	a := make([]string, 4)
+	for i := range z {
+		a[i] = z[i].String()
+	}
+	return "[" + strings.Join(a, " ") + "]"
+}
+
+// GoString returns the Go syntax of a Laguerre value, as a call to
+// NewLaguerre. It is used by the "%#v" verb.
+func (z *Laguerre) GoString() string {
+	a := make([]string, 4)
+	for i := range z {
+		a[i] = z[i].GoString()
+	}
+	return "dual.NewLaguerre(" + strings.Join(a, ", ") + ")"
+}
+
+// Equals returns true if z and y are equal.
+func (z *Laguerre) Equals(y *Laguerre) bool {
+	for i := range z {
+		if !z[i].Equals(&y[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Hash64 returns a map-key hash of z; see the package documentation.
+func (z *Laguerre) Hash64(step float64) uint64 {
+	v := make([]float64, 0, 8)
+	for i := range z {
+		v = append(v, z[i][:]...)
+	}
+	return hash64(v, step)
+}
+
+// Copy copies y onto z, and returns z.
+func (z *Laguerre) Copy(y *Laguerre) *Laguerre {
+	for i := range z {
+		z[i].Copy(&y[i])
+	}
+	return z
+}
+
+// NewLaguerre returns a pointer to a Laguerre value made from four given Real
+// coefficients.
+func NewLaguerre(a, b, c, d *Real) *Laguerre {
+	z := new(Laguerre)
+	z[0].Copy(a)
+	z[1].Copy(b)
+	z[2].Copy(c)
+	z[3].Copy(d)
+	return z
+}
+
+// LaguerreRotation returns a pointer to the Laguerre value that adds the dual
+// angle α to the dual angle of every oriented line. If α = φ + rε, then this
+// is the rotation by φ about the origin followed by the dilation that moves
+// every oriented line a distance r along its normal.
+func LaguerreRotation(α *DualAngle) *Laguerre {
+	h := NewDualAngle(α.Angle()/2, α.Distance()/2)
+	c, s := h.Cos(), h.Sin()
+	return NewLaguerre(c, s, new(Real).Neg(s), c)
+}
+
+// LaguerreTranslation returns a pointer to the Laguerre value for the
+// translation of the plane by the vector (a, b).
+func LaguerreTranslation(a, b float64) *Laguerre {
+	return NewLaguerre(
+		NewReal(1, b/2),
+		NewReal(0, a/2),
+		NewReal(0, a/2),
+		NewReal(1, -b/2),
+	)
+}
+
+// Mul sets z equal to the composition of x and y, and returns z. The
+// transformation y is applied first, followed by x.
+func (z *Laguerre) Mul(x, y *Laguerre) *Laguerre {
+	p, q := *x, *y
+	var s, t Real
+	z[0].Add(s.Mul(&p[0], &q[0]), t.Mul(&p[1], &q[2]))
+	z[1].Add(s.Mul(&p[0], &q[1]), t.Mul(&p[1], &q[3]))
+	z[2].Add(s.Mul(&p[2], &q[0]), t.Mul(&p[3], &q[2]))
+	z[3].Add(s.Mul(&p[2], &q[1]), t.Mul(&p[3], &q[3]))
+	return z
+}
+
+// Det returns the determinant ad - bc of z, a pointer to a Real value.
+func (z *Laguerre) Det() *Real {
+	return new(Real).Sub(new(Real).Mul(&z[0], &z[3]), new(Real).Mul(&z[1], &z[2]))
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If the determinant of y
+// is a zero divisor, then Inv panics.
+func (z *Laguerre) Inv(y *Laguerre) *Laguerre {
+	d := y.Det()
+	if d.IsZeroDiv() {
+		panic("zero divisor determinant")
+	}
+	d.Inv(d)
+	p := *y
+	z[0].Mul(&p[3], d)
+	z[1].Mul(p[1].Neg(&p[1]), d)
+	z[2].Mul(p[2].Neg(&p[2]), d)
+	z[3].Mul(&p[0], d)
+	return z
+}
+
+// InvChecked sets z equal to the inverse of y, and returns z and a nil error.
+// If the determinant of y is a zero divisor, then z is left unchanged and
+// ErrZeroDivisor is returned in place of the panic of Inv.
+func (z *Laguerre) InvChecked(y *Laguerre) (*Laguerre, error) {
+	if y.Det().IsZeroDiv() {
+		return z, ErrZeroDivisor
+	}
+	return z.Inv(y), nil
+}
+
+// Laguerre sets z equal to the oriented line y moved by the Laguerre
+// transformation m, and returns z.
+func (z *Complex) Laguerre(y *Complex, m *Laguerre) *Complex {
+	x0, x1 := y.homogeneous()
+	return z.Copy(orientedLine(
+		new(Real).Add(new(Real).Mul(&m[2], x1), new(Real).Mul(&m[3], x0)),
+		new(Real).Add(new(Real).Mul(&m[0], x1), new(Real).Mul(&m[1], x0)),
+	))
+}
+
+// FixedLines returns the oriented lines left unchanged by z. A Laguerre
+// transformation fixes at most two oriented lines unless it fixes the
+// direction of every line (as translations do), in which case FixedLines
+// returns nil.
+func (z *Laguerre) FixedLines() []*Complex {
+	// Fixed lines solve c t² + (d - a) t - b = 0 in homogeneous form. The real
+	// parts of the roots solve the real quadratic, and the dual parts follow
+	// from its linearization.
+	a, b, c, d := z[0].Real(), z[1].Real(), z[2].Real(), z[3].Real()
+	e, f, g, h := z[0].Dual(), z[1].Dual(), z[2].Dual(), z[3].Dual()
+	if !notEquals(b, 0) && !notEquals(c, 0) && !notEquals(a, d) {
+		return nil
+	}
+	var lines []*Complex
+	if !notEquals(c, 0) {
+		// The line with t = ∞ is fixed.
+		if notEquals(d, a) {
+			lines = append(lines, orientedLine(NewReal(0, -g/(d-a)), NewReal(1, 0)))
+		}
+	}
+	var roots []float64
+	switch {
+	case !notEquals(c, 0):
+		if notEquals(d, a) {
+			roots = append(roots, b/(d-a))
+		}
+	default:
+		disc := ((d - a) * (d - a)) + (4 * b * c)
+		if disc < -delta {
+			return lines
+		}
+		s := math.Sqrt(math.Max(disc, 0))
+		roots = append(roots, (a-d+s)/(2*c))
+		if s > delta {
+			roots = append(roots, (a-d-s)/(2*c))
+		}
+	}
+	for _, t := range roots {
+		den := (2 * c * t) + (d - a)
+		if !notEquals(den, 0) {
+			continue
+		}
+		s := -((g * t * t) + ((h - e) * t) - f) / den
+		lines = append(lines, orientedLine(NewReal(1, 0), NewReal(t, s)))
+	}
+	return lines
+}
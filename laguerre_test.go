@@ -0,0 +1,126 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestOrientedLine(t *testing.T) {
+	for _, test := range []struct {
+		θ, p float64
+	}{
+		{0, 0},
+		{0.5, 2},
+		{-2, -1.5},
+		{math.Pi, 3},
+		{math.Pi / 2, -0.25},
+	} {
+		l := NewOrientedLine(test.θ, test.p)
+		θ, p := l.OrientedLine()
+		if math.Abs(θ-test.θ) > 1e-12 || math.Abs(p-test.p) > 1e-12 {
+			t.Errorf("NewOrientedLine(%v, %v).OrientedLine() = %v, %v", test.θ, test.p, θ, p)
+		}
+		if m := NewOrientedLine(test.θ+(2*math.Pi), test.p); !m.Equals(l) {
+			t.Errorf("NewOrientedLine(%v+2π, %v) = %v, want %v", test.θ, test.p, m, l)
+		}
+	}
+	// The normal angle is reduced to (-π, π].
+	if θ, p := NewOrientedLine(-math.Pi, 1).OrientedLine(); math.Abs(θ-math.Pi) > 1e-12 || math.Abs(p-1) > 1e-12 {
+		t.Errorf("NewOrientedLine(-π, 1).OrientedLine() = %v, %v, want π, 1", θ, p)
+	}
+}
+
+func TestLaguerreAction(t *testing.T) {
+	θ, p := 0.7, 1.25
+	l := NewOrientedLine(θ, p)
+	// A rotation with dilation adds its dual angle to that of the line.
+	got := new(Complex).Laguerre(l, LaguerreRotation(NewDualAngle(0.4, 0.5)))
+	if want := NewOrientedLine(θ+0.4, p+0.5); !got.Equals(want) {
+		t.Errorf("rotation of %v = %v, want %v", l, got, want)
+	}
+	// A translation by (a, b) adds a cos θ + b sin θ to the distance.
+	a, b := 2.0, -3.0
+	got = new(Complex).Laguerre(l, LaguerreTranslation(a, b))
+	if want := NewOrientedLine(θ, p+(a*math.Cos(θ))+(b*math.Sin(θ))); !got.Equals(want) {
+		t.Errorf("translation of %v = %v, want %v", l, got, want)
+	}
+}
+
+func TestLaguerreMul(t *testing.T) {
+	x := LaguerreRotation(NewDualAngle(0.3, 0.2))
+	y := LaguerreTranslation(1, -2)
+	xy := new(Laguerre).Mul(x, y)
+	for _, l := range []*Complex{NewOrientedLine(0, 1), NewOrientedLine(2.5, -0.5)} {
+		got := new(Complex).Laguerre(l, xy)
+		want := new(Complex).Laguerre(new(Complex).Laguerre(l, y), x)
+		if !got.Equals(want) {
+			t.Errorf("%v moved by x·y = %v, want y then x = %v", l, got, want)
+		}
+	}
+	// Rotations compose by adding their dual angles.
+	got := new(Laguerre).Mul(LaguerreRotation(NewDualAngle(0.3, 0.2)), LaguerreRotation(NewDualAngle(-1, 0.5)))
+	if want := LaguerreRotation(NewDualAngle(-0.7, 0.7)); !got.Equals(want) {
+		t.Errorf("Mul of rotations = %v, want %v", got, want)
+	}
+	// Mul is safe when z aliases an argument.
+	z := *x
+	if z.Mul(&z, y); !z.Equals(xy) {
+		t.Errorf("Mul in place = %v, want %v", &z, xy)
+	}
+}
+
+func TestLaguerreInv(t *testing.T) {
+	one := NewLaguerre(NewReal(1, 0), NewReal(0, 0), NewReal(0, 0), NewReal(1, 0))
+	for _, x := range []*Laguerre{
+		LaguerreRotation(NewDualAngle(0.3, 0.2)),
+		LaguerreTranslation(1, -2),
+		NewLaguerre(NewReal(2, 0.1), NewReal(1, 0.3), NewReal(1, -0.2), NewReal(1, 0.4)),
+	} {
+		inv := new(Laguerre).Inv(x)
+		if got := new(Laguerre).Mul(inv, x); !got.Equals(one) {
+			t.Errorf("Inv(%v)·%[1]v = %v, want %v", x, got, one)
+		}
+		if got := new(Laguerre).Mul(x, inv); !got.Equals(one) {
+			t.Errorf("%v·Inv(%[1]v) = %v, want %v", x, got, one)
+		}
+	}
+	singular := NewLaguerre(NewReal(1, 0), NewReal(2, 0), NewReal(2, 1), NewReal(4, 0))
+	z := new(Laguerre).Copy(one)
+	if _, err := z.InvChecked(singular); !errors.Is(err, ErrZeroDivisor) || !z.Equals(one) {
+		t.Errorf("InvChecked(%v) = %v, %v", singular, z, err)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Inv(%v) did not panic", singular)
+		}
+	}()
+	new(Laguerre).Inv(singular)
+}
+
+func TestFixedLines(t *testing.T) {
+	for _, test := range []struct {
+		m *Laguerre
+		n int
+	}{
+		{NewLaguerre(NewReal(2, 0.1), NewReal(1, 0.3), NewReal(1, -0.2), NewReal(1, 0.4)), 2},
+		{NewLaguerre(NewReal(2, 0.1), NewReal(1, 0.3), NewReal(0, 0.5), NewReal(1, 0.4)), 2},
+		{LaguerreRotation(NewDualAngle(0.3, 0.2)), 0},
+	} {
+		lines := test.m.FixedLines()
+		if len(lines) != test.n {
+			t.Errorf("FixedLines(%v) = %v, want %d lines", test.m, lines, test.n)
+		}
+		for _, l := range lines {
+			if got := new(Complex).Laguerre(l, test.m); !got.Equals(l) {
+				t.Errorf("FixedLines(%v) has %v, which moves to %v", test.m, l, got)
+			}
+		}
+	}
+	if lines := LaguerreTranslation(1, -2).FixedLines(); lines != nil {
+		t.Errorf("FixedLines of a translation = %v, want nil", lines)
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "strings"
+
+// A Line represents an oriented spatial line as a dual 3-vector. The real
+// parts of the components are the unit direction u of the line, and the dual
+// parts are its moment m = p × u about the origin, with p any point on the
+// line. These are the Plücker coordinates of the line.
+type Line [3]Real
+
+// Direction returns the direction of z, a unit 3-vector.
+func (z *Line) Direction() [3]float64 {
+	return [3]float64{z[0].Real(), z[1].Real(), z[2].Real()}
+}
+
+// Moment returns the moment of z about the origin, a 3-vector.
+func (z *Line) Moment() [3]float64 {
+	return [3]float64{z[0].Dual(), z[1].Dual(), z[2].Dual()}
+}
+
+// Point returns the point of z closest to the origin.
+func (z *Line) Point() [3]float64 {
+	return cross3(z.Direction(), z.Moment())
+}
+
+// String returns the string version of a Line value.
+//
+// If z has direction u and moment m, then the string is
+// "[(u₀+m₀ε) (u₁+m₁ε) (u₂+m₂ε)]".
+func (z *Line) String() string {
+	a := make([]string, 3)
+	for i := range z {
+		a[i] = z[i].String()
+	}
+	return "[" + strings.Join(a, " ") + "]"
+}
+
+// GoString returns the Go syntax of a Line value, as a call to NewLinePlucker.
+// It is used by the "%#v" verb.
+func (z *Line) GoString() string {
+	u, m := z.Direction(), z.Moment()
+	return "dual.NewLinePlucker([3]float64{" + goFloats(u[:]) +
+		"}, [3]float64{" + goFloats(m[:]) + "})"
+}
+
+// Equals returns true if z and y are equal.
+func (z *Line) Equals(y *Line) bool {
+	for i := range z {
+		if !z[i].Equals(&y[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy copies y onto z, and returns z.
+func (z *Line) Copy(y *Line) *Line {
+	for i := range z {
+		z[i].Copy(&y[i])
+	}
+	return z
+}
+
+// NewLine returns a pointer to the Line value through the points p and q,
+// oriented from p to q.
+func NewLine(p, q [3]float64) *Line {
+	return NewLinePlucker(sub3(q, p), cross3(p, unit3(sub3(q, p))))
+}
+
+// NewLinePlucker returns a pointer to a Line value made from a direction u and
+// a moment m. The direction is scaled to unit length, and the part of m along
+// u is discarded so that the Plücker condition u · m = 0 holds.
+func NewLinePlucker(u, m [3]float64) *Line {
+	u = unit3(u)
+	m = sub3(m, scale3(u, dot3(u, m)))
+	z := new(Line)
+	for i := range z {
+		z[i].SetReal(u[i])
+		z[i].SetDual(m[i])
+	}
+	return z
+}
+
+// Neg sets z equal to y with the opposite orientation, and returns z.
+func (z *Line) Neg(y *Line) *Line {
+	for i := range z {
+		z[i].Neg(&y[i])
+	}
+	return z
+}
+
+// Angle returns the dual angle from z to y, a pointer to a DualAngle value.
+// The angle part is the angle between the directions of z and y, and the
+// distance part is the distance between them along their common normal.
+func (z *Line) Angle(y *Line) *DualAngle {
+	return DualAngleBetween(z.Point(), z.Direction(), y.Point(), y.Direction())
+}
+
+// CommonNormal returns the common normal of z and y, a pointer to a Line
+// value. The common normal meets both lines at right angles and is oriented
+// along the cross product of their directions. If z and y are parallel, then
+// the common normal is not unique, and the one through the point of z closest
+// to the origin is returned.
+func (z *Line) CommonNormal(y *Line) *Line {
+	u, v := z.Direction(), y.Direction()
+	p, q := z.Point(), y.Point()
+	n := cross3(u, v)
+	if norm3(n) <= delta {
+		w := sub3(q, p)
+		n = sub3(w, scale3(u, dot3(w, u)))
+		return NewLinePlucker(n, cross3(p, unit3(n)))
+	}
+	c := linePoint(p, u, q, v)
+	return NewLinePlucker(n, cross3(c, unit3(n)))
+}
+
+// ClosestPoints returns the point p of z closest to y and the point q of y
+// closest to z. The segment from p to q lies on the common normal, and its
+// length is the distance part of the dual angle between z and y. With
+// n = u × v for the directions u and v, and the moments m and k, the points
+// follow from the Plücker coordinates alone:
+// 		p = (-m × (v × n) + (k · n)u) / |n|²
+// 		q = (k × (u × n) - (m · n)v) / |n|²
+// If z and y are parallel, then p is the point of z closest to the origin.
+func (z *Line) ClosestPoints(y *Line) (p, q [3]float64) {
+	u, m := z.Direction(), z.Moment()
+	v, k := y.Direction(), y.Moment()
+	n := cross3(u, v)
+	s := dot3(n, n)
+	if s <= delta*delta {
+		p = z.Point()
+		c := y.Point()
+		return p, add3(c, scale3(v, dot3(sub3(p, c), v)))
+	}
+	p = add3(cross3(cross3(v, n), m), scale3(u, dot3(k, n)))
+	q = sub3(cross3(k, cross3(u, n)), scale3(v, dot3(m, n)))
+	return scale3(p, 1/s), scale3(q, 1/s)
+}
+
+// Transform sets z equal to y moved by the rigid motion encoded in the unit
+// dual quaternion q, and returns z.
+//
+// The motion q = r + εd is a rotation by the unit quaternion r followed by a
+// translation by t, with d = ½tr. The direction u and moment m transform as:
+// 		u' = R(u)
+// 		m' = R(m) + t × R(u)
+func (z *Line) Transform(y *Line, q *Hamilton) *Line {
+	u, m := transform3(q, y.Direction(), y.Moment())
+	for i := range z {
+		z[i].SetReal(u[i])
+		z[i].SetDual(m[i])
+	}
+	return z
+}
+
+// transform3 returns the dual 3-vector u + εm moved by the rigid motion encoded
+// in the unit dual quaternion q, as in Line.Transform.
+func transform3(q *Hamilton, u, m [3]float64) ([3]float64, [3]float64) {
+	u = rotate3(q, u)
+	return u, add3(rotate3(q, m), cross3(translation3(q), u))
+}
+
+// linePoint returns the point on the line through p with direction u that is
+// closest to the line through q with direction v. The lines must not be
+// parallel.
+func linePoint(p, u, q, v [3]float64) [3]float64 {
+	w := sub3(p, q)
+	a, b, c := dot3(u, u), dot3(u, v), dot3(v, v)
+	d, e := dot3(u, w), dot3(v, w)
+	s := ((b * e) - (c * d)) / ((a * c) - (b * b))
+	return add3(p, scale3(u, s))
+}
+
+// rotate3 returns the 3-vector v rotated by the real part of the unit dual
+// quaternion q.
+func rotate3(q *Hamilton, v [3]float64) [3]float64 {
+	w, _ := q.ScalarPart()
+	r, _ := q.VectorPart()
+	t := scale3(cross3(r, v), 2)
+	return add3(add3(v, scale3(t, w)), cross3(r, t))
+}
+
+// translation3 returns the translation 3-vector t = 2dr* encoded in the unit
+// dual quaternion q = r + εd.
+func translation3(q *Hamilton) [3]float64 {
+	w, e := q.ScalarPart()
+	r, d := q.VectorPart()
+	r = scale3(r, -1)
+	t := add3(add3(scale3(r, e), scale3(d, w)), cross3(d, r))
+	return scale3(t, 2)
+}
+
+// add3 returns the sum of two 3-vectors.
+func add3(u, v [3]float64) [3]float64 {
+	return [3]float64{u[0] + v[0], u[1] + v[1], u[2] + v[2]}
+}
+
+// scale3 returns the 3-vector u scaled by a.
+func scale3(u [3]float64, a float64) [3]float64 {
+	return [3]float64{u[0] * a, u[1] * a, u[2] * a}
+}
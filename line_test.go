@@ -0,0 +1,140 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"testing"
+)
+
+// near3 returns true if the 3-vectors u and v are equal to within 1e-12.
+func near3(u, v [3]float64) bool {
+	return norm3(sub3(u, v)) < 1e-12
+}
+
+func TestNewLine(t *testing.T) {
+	for _, test := range []struct {
+		p, q [3]float64
+	}{
+		{[3]float64{0, 0, 0}, [3]float64{1, 0, 0}},
+		{[3]float64{1, 2, 3}, [3]float64{-2, 5, 0.5}},
+		{[3]float64{0, 3, 0}, [3]float64{1, 3, 4}},
+	} {
+		l := NewLine(test.p, test.q)
+		u, m := l.Direction(), l.Moment()
+		if want := unit3(sub3(test.q, test.p)); !near3(u, want) {
+			t.Errorf("NewLine(%v, %v) has direction %v, want %v", test.p, test.q, u, want)
+		}
+		if want := cross3(test.q, u); !near3(m, want) {
+			t.Errorf("NewLine(%v, %v) has moment %v, want q × u = %v", test.p, test.q, m, want)
+		}
+		if d := dot3(u, m); math.Abs(d) > 1e-12 {
+			t.Errorf("NewLine(%v, %v) has u · m = %v, want 0", test.p, test.q, d)
+		}
+		// The point closest to the origin is on the line, at a right angle.
+		c := l.Point()
+		if !near3(cross3(sub3(c, test.p), u), [3]float64{}) || math.Abs(dot3(c, u)) > 1e-12 {
+			t.Errorf("NewLine(%v, %v).Point() = %v", test.p, test.q, c)
+		}
+	}
+	// NewLinePlucker scales u to unit length and drops the part of m along u.
+	l := NewLinePlucker([3]float64{0, 0, 2}, [3]float64{1, -1, 5})
+	if want := NewLinePlucker([3]float64{0, 0, 1}, [3]float64{1, -1, 0}); !l.Equals(want) {
+		t.Errorf("NewLinePlucker = %v, want %v", l, want)
+	}
+	if d := dot3(l.Direction(), l.Moment()); d != 0 {
+		t.Errorf("NewLinePlucker has u · m = %v, want 0", d)
+	}
+	if got := NewLine(l.Point(), add3(l.Point(), l.Direction())); !got.Equals(l) {
+		t.Errorf("NewLine through the points of %v = %v", l, got)
+	}
+}
+
+func TestCommonNormal(t *testing.T) {
+	// The x axis and the line through (0, 0, 2) along y are at a right angle,
+	// a distance 2 apart along the z axis.
+	x := NewLine([3]float64{0, 0, 0}, [3]float64{1, 0, 0})
+	y := NewLine([3]float64{0, 0, 2}, [3]float64{0, 1, 2})
+	if got, want := x.CommonNormal(y), NewLinePlucker([3]float64{0, 0, 1}, [3]float64{}); !got.Equals(want) {
+		t.Errorf("CommonNormal(%v, %v) = %v, want %v", x, y, got, want)
+	}
+	if got, want := x.Angle(y), NewDualAngle(math.Pi/2, 2); !got.Equals(want) {
+		t.Errorf("Angle(%v, %v) = %v, want %v", x, y, got, want)
+	}
+	// From y to x, the normal and the distance both flip, so the distance along
+	// the normal is unchanged.
+	if got, want := y.CommonNormal(x), NewLinePlucker([3]float64{0, 0, -1}, [3]float64{}); !got.Equals(want) {
+		t.Errorf("CommonNormal(%v, %v) = %v, want %v", y, x, got, want)
+	}
+	if got, want := y.Angle(x), NewDualAngle(math.Pi/2, 2); !got.Equals(want) {
+		t.Errorf("Angle(%v, %v) = %v, want %v", y, x, got, want)
+	}
+	if p, q := x.ClosestPoints(y); !near3(p, [3]float64{}) || !near3(q, [3]float64{0, 0, 2}) {
+		t.Errorf("ClosestPoints(%v, %v) = %v, %v", x, y, p, q)
+	}
+	// Parallel lines have the normal through the point of x closest to the
+	// origin.
+	z := NewLine([3]float64{5, 3, 0}, [3]float64{7, 3, 0})
+	if got, want := x.CommonNormal(z), NewLinePlucker([3]float64{0, 1, 0}, [3]float64{}); !got.Equals(want) {
+		t.Errorf("CommonNormal(%v, %v) = %v, want %v", x, z, got, want)
+	}
+	if got, want := x.Angle(z), NewDualAngle(0, 3); !got.Equals(want) {
+		t.Errorf("Angle(%v, %v) = %v, want %v", x, z, got, want)
+	}
+}
+
+func TestClosestPoints(t *testing.T) {
+	l := NewLine([3]float64{1, 2, 3}, [3]float64{-2, 5, 0.5})
+	k := NewLine([3]float64{0, 3, 0}, [3]float64{1, 3, 4})
+	p, q := l.ClosestPoints(k)
+	if want := linePoint(l.Point(), l.Direction(), k.Point(), k.Direction()); !near3(p, want) {
+		t.Errorf("p = %v, want %v", p, want)
+	}
+	if want := linePoint(k.Point(), k.Direction(), l.Point(), l.Direction()); !near3(q, want) {
+		t.Errorf("q = %v, want %v", q, want)
+	}
+	if d, want := norm3(sub3(q, p)), l.Angle(k).Distance(); math.Abs(d-math.Abs(want)) > 1e-12 {
+		t.Errorf("|q - p| = %v, want %v", d, want)
+	}
+	n := l.CommonNormal(k)
+	if m := NewLine(p, q); !m.Equals(n) && !m.Equals(new(Line).Neg(n)) {
+		t.Errorf("segment pq = %v, want the common normal %v", m, n)
+	}
+	// Parallel lines one unit apart.
+	a := NewLine([3]float64{0, 0, 0}, [3]float64{1, 0, 0})
+	b := NewLine([3]float64{5, 1, 0}, [3]float64{7, 1, 0})
+	p, q = a.ClosestPoints(b)
+	if !near3(p, [3]float64{}) || !near3(q, [3]float64{0, 1, 0}) {
+		t.Errorf("parallel ClosestPoints = %v, %v", p, q)
+	}
+}
+
+func TestLineTransform(t *testing.T) {
+	// The motion of a line is that of the line through its moved points, with
+	// each point rotated by Rodrigues' formula and then translated.
+	k, θ := unit3([3]float64{1, -2, 2}), 0.9
+	tr := [3]float64{0.5, 3, -1}
+	move := func(v [3]float64) [3]float64 {
+		s, c := math.Sincos(θ)
+		r := add3(scale3(v, c), scale3(cross3(k, v), s))
+		r = add3(r, scale3(k, dot3(k, v)*(1-c)))
+		return add3(r, tr)
+	}
+	q := motion(k, θ, tr)
+	for _, test := range []struct {
+		p, r [3]float64
+	}{
+		{[3]float64{0, 0, 0}, [3]float64{1, 0, 0}},
+		{[3]float64{1, 2, 3}, [3]float64{-2, 5, 0.5}},
+	} {
+		l := NewLine(test.p, test.r)
+		want := NewLine(move(test.p), move(test.r))
+		if got := new(Line).Transform(l, q); !got.Equals(want) {
+			t.Errorf("Transform(%v) = %v, want %v", l, got, want)
+		}
+		if got := l.Transform(l, q); !got.Equals(want) {
+			t.Errorf("Transform in place = %v, want %v", got, want)
+		}
+	}
+}
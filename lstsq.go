@@ -0,0 +1,86 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+// LeastSquares returns the least-squares solution x of the overdetermined
+// linear system ax = b, which minimizes the Euclidean norm of ax - b, as a
+// DualVector value. The dual parts of x are the derivatives of the solution
+// along the dual parts of a and b. If a has fewer rows than columns, or if the
+// lengths do not match, then LeastSquares panics. If the real part of a does
+// not have full column rank, then LeastSquares returns ErrZeroDivisor.
+//
+// The system is solved by a QR decomposition of a, computed with modified
+// Gram-Schmidt and one pass of reorthogonalization in dual arithmetic.
+func LeastSquares(a *DualMatrix, b DualVector) (DualVector, error) {
+	m, n := a.Dims()
+	if m < n {
+		panic("underdetermined system")
+	}
+	checkLen(m, len(b))
+	q, r, ok := a.qr()
+	if !ok {
+		return nil, ErrZeroDivisor
+	}
+	// Solve Rx = Qᵀb by back substitution.
+	x := make(DualVector, n)
+	col := make(DualVector, m)
+	t := new(Real)
+	for i := n - 1; i >= 0; i-- {
+		x[i].Copy(q.column(i, col).Dot(b))
+		for j := i + 1; j < n; j++ {
+			x[i].Sub(&x[i], t.Mul(r.At(i, j), &x[j]))
+		}
+		x[i].Quo(&x[i], r.At(i, i))
+	}
+	return x, nil
+}
+
+// qr returns the thin QR decomposition of z, with q an m×n matrix with
+// orthonormal columns and r an n×n upper triangular matrix. If a diagonal
+// element of r is a zero divisor, then ok is false.
+func (z *DualMatrix) qr() (q, r *DualMatrix, ok bool) {
+	m, n := z.Dims()
+	q = new(DualMatrix).Copy(z)
+	r = NewDualMatrix(n, n, nil)
+	u, v := make(DualVector, m), make(DualVector, m)
+	s, t := new(Real), new(Real)
+	for j := 0; j < n; j++ {
+		q.column(j, u)
+		for pass := 0; pass < 2; pass++ {
+			for i := 0; i < j; i++ {
+				s.Copy(q.column(i, v).Dot(u))
+				r.At(i, j).Add(r.At(i, j), s)
+				for k := range u {
+					u[k].Sub(&u[k], t.Mul(s, &v[k]))
+				}
+			}
+		}
+		d := u.Norm()
+		if d.IsZeroDiv() {
+			return nil, nil, false
+		}
+		r.SetAt(j, j, d)
+		d.Inv(d)
+		u.Scale(u, d)
+		q.setColumn(j, u)
+	}
+	return q, r, true
+}
+
+// column copies column j of z onto v, and returns v.
+func (z *DualMatrix) column(j int, v DualVector) DualVector {
+	checkLen(z.rows, len(v))
+	for i := range v {
+		v[i] = z.data[i*z.cols+j]
+	}
+	return v
+}
+
+// setColumn copies v onto column j of z.
+func (z *DualMatrix) setColumn(j int, v DualVector) {
+	checkLen(z.rows, len(v))
+	for i := range v {
+		z.data[i*z.cols+j] = v[i]
+	}
+}
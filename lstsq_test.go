@@ -0,0 +1,32 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "testing"
+
+func TestLeastSquares(t *testing.T) {
+	// Fit y = c₀ + c₁x to the points (0, 1), (1, 2+ε), (2, 2), (3, 4). The
+	// dual part of each coefficient is its derivative with respect to the
+	// second data point.
+	a := NewDualMatrix(4, 2, []Real{{1, 0}, {0, 0}, {1, 0}, {1, 0}, {1, 0}, {2, 0}, {1, 0}, {3, 0}})
+	b := DualVector{{1, 0}, {2, 1}, {2, 0}, {4, 0}}
+	x, err := LeastSquares(a, b)
+	if err != nil {
+		t.Fatalf("LeastSquares: %v", err)
+	}
+	if want := (DualVector{{0.9, 0.4}, {0.9, -0.1}}); !x.EqualsTol(want, 1e-12) {
+		t.Errorf("LeastSquares = %v, want %v", x, want)
+	}
+	// A square system has the exact solution.
+	s := NewDualMatrix(2, 2, []Real{{2, 1}, {1, 0}, {1, 0}, {3, 2}})
+	y, _ := LeastSquares(s, b[:2])
+	z, _ := Solve(s, b[:2])
+	if !y.EqualsTol(z, 1e-12) {
+		t.Errorf("LeastSquares = %v, want %v", y, z)
+	}
+	r := NewDualMatrix(3, 2, []Real{{1, 0}, {2, 1}, {1, 0}, {2, 0}, {1, 0}, {2, 0}})
+	if _, err := LeastSquares(r, b[:3]); err != ErrZeroDivisor {
+		t.Errorf("LeastSquares rank deficient: err = %v, want %v", err, ErrZeroDivisor)
+	}
+}
@@ -0,0 +1,406 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"strings"
+)
+
+// A DualMatrix represents a dense matrix of dual numbers, with its Real
+// components stored in row-major order. The zero value is an empty matrix, and
+// the methods that set a DualMatrix resize it as needed.
+type DualMatrix struct {
+	rows, cols int
+	data       []Real
+}
+
+// NewDualMatrix returns a pointer to a DualMatrix value with r rows and c
+// columns. If data is nil, then the matrix is zero; otherwise data holds the
+// components in row-major order and is used as the backing slice. If data does
+// not have r*c elements, then NewDualMatrix panics.
+func NewDualMatrix(r, c int, data []Real) *DualMatrix {
+	if r < 0 || c < 0 {
+		panic("negative dimension")
+	}
+	if data == nil {
+		data = make([]Real, r*c)
+	}
+	if len(data) != r*c {
+		panic("mismatched dimensions")
+	}
+	return &DualMatrix{rows: r, cols: c, data: data}
+}
+
+// NewDualIdentity returns a pointer to the n×n identity DualMatrix value.
+func NewDualIdentity(n int) *DualMatrix {
+	z := NewDualMatrix(n, n, nil)
+	for i := 0; i < n; i++ {
+		z.data[i*n+i] = Real{1, 0}
+	}
+	return z
+}
+
+// Dims returns the number of rows and columns of z.
+func (z *DualMatrix) Dims() (r, c int) {
+	return z.rows, z.cols
+}
+
+// At returns the component of z in row i and column j, a pointer to a Real
+// value. Changes through the pointer change z.
+func (z *DualMatrix) At(i, j int) *Real {
+	if i < 0 || i >= z.rows || j < 0 || j >= z.cols {
+		panic("index out of range")
+	}
+	return &z.data[i*z.cols+j]
+}
+
+// SetAt sets the component of z in row i and column j equal to a.
+func (z *DualMatrix) SetAt(i, j int, a *Real) {
+	z.At(i, j).Copy(a)
+}
+
+// Row returns row i of z, a DualVector value sharing storage with z.
+func (z *DualMatrix) Row(i int) DualVector {
+	if i < 0 || i >= z.rows {
+		panic("index out of range")
+	}
+	return DualVector(z.data[i*z.cols : (i+1)*z.cols : (i+1)*z.cols])
+}
+
+// String returns the string version of a DualMatrix value.
+//
+// The string is "[[a b] [c d]]", with each row printed as a DualVector value.
+func (z *DualMatrix) String() string {
+	a := make([]string, z.rows)
+	for i := range a {
+		a[i] = z.Row(i).String()
+	}
+	return "[" + strings.Join(a, " ") + "]"
+}
+
+// Equals returns true if z and y have the same dimensions and equal
+// components.
+func (z *DualMatrix) Equals(y *DualMatrix) bool {
+	if z.rows != y.rows || z.cols != y.cols {
+		return false
+	}
+	return DualVector(z.data).Equals(DualVector(y.data))
+}
+
+// Copy copies y onto z, and returns z.
+func (z *DualMatrix) Copy(y *DualMatrix) *DualMatrix {
+	if z == y {
+		return z
+	}
+	z.reuse(y.rows, y.cols)
+	copy(z.data, y.data)
+	return z
+}
+
+// Add sets z equal to the sum of x and y, and returns z.
+func (z *DualMatrix) Add(x, y *DualMatrix) *DualMatrix {
+	checkDims(x, y)
+	z.reuse(x.rows, x.cols)
+	DualVector(z.data).Add(x.data, y.data)
+	return z
+}
+
+// Sub sets z equal to the difference of x and y, and returns z.
+func (z *DualMatrix) Sub(x, y *DualMatrix) *DualMatrix {
+	checkDims(x, y)
+	z.reuse(x.rows, x.cols)
+	DualVector(z.data).Sub(x.data, y.data)
+	return z
+}
+
+// Scale sets z equal to y scaled by a, and returns z.
+func (z *DualMatrix) Scale(y *DualMatrix, a *Real) *DualMatrix {
+	z.reuse(y.rows, y.cols)
+	DualVector(z.data).Scale(y.data, a)
+	return z
+}
+
+// Mul sets z equal to the matrix product of x and y, and returns z. If the
+// number of columns of x is not the number of rows of y, then Mul panics.
+func (z *DualMatrix) Mul(x, y *DualMatrix) *DualMatrix {
+	if x.cols != y.rows {
+		panic("mismatched dimensions")
+	}
+	p := NewDualMatrix(x.rows, y.cols, nil)
+	t := new(Real)
+	for i := 0; i < x.rows; i++ {
+		for j := 0; j < y.cols; j++ {
+			s := &p.data[i*p.cols+j]
+			for k := 0; k < x.cols; k++ {
+				s.Add(s, t.Mul(&x.data[i*x.cols+k], &y.data[k*y.cols+j]))
+			}
+		}
+	}
+	return z.Copy(p)
+}
+
+// MulVec sets z equal to the product of the matrix x and the column vector y,
+// and returns z.
+func (z DualVector) MulVec(x *DualMatrix, y DualVector) DualVector {
+	checkLen(x.cols, len(y))
+	checkLen(x.rows, len(z))
+	p := make(DualVector, len(z))
+	for i := range p {
+		p[i].Copy(x.Row(i).Dot(y))
+	}
+	return z.Copy(p)
+}
+
+// Transpose sets z equal to the transpose of y, and returns z.
+func (z *DualMatrix) Transpose(y *DualMatrix) *DualMatrix {
+	p := NewDualMatrix(y.cols, y.rows, nil)
+	for i := 0; i < y.rows; i++ {
+		for j := 0; j < y.cols; j++ {
+			p.data[j*p.cols+i] = y.data[i*y.cols+j]
+		}
+	}
+	return z.Copy(p)
+}
+
+// Det returns the determinant of z, a pointer to a Real value. The dual part
+// of the determinant is its derivative along the dual parts of z. If z is not
+// square, then Det panics.
+func (z *DualMatrix) Det() *Real {
+	lu, _, sign, ok := z.lu()
+	if !ok {
+		// A zero divisor pivot makes the determinant a zero divisor, but its
+		// dual part can still be nonzero.
+		return z.detColumns()
+	}
+	d := NewReal(sign, 0)
+	for i := 0; i < lu.rows; i++ {
+		d.Mul(d, &lu.data[i*lu.cols+i])
+	}
+	return d
+}
+
+// Inv sets z equal to the inverse of y, and returns z. If y is not square, or
+// if its determinant is a zero divisor, then Inv panics.
+//
+// The inverse is computed by Gauss-Jordan elimination with partial pivoting on
+// the real parts, carried out in dual arithmetic.
+func (z *DualMatrix) Inv(y *DualMatrix) *DualMatrix {
+	p, ok := y.inv()
+	if !ok {
+		panic("zero divisor determinant")
+	}
+	return z.Copy(p)
+}
+
+// InvChecked sets z equal to the inverse of y, and returns z and a nil error.
+// If the determinant of y is a zero divisor, then z is left unchanged and
+// ErrZeroDivisor is returned in place of the panic of Inv.
+func (z *DualMatrix) InvChecked(y *DualMatrix) (*DualMatrix, error) {
+	p, ok := y.inv()
+	if !ok {
+		return z, ErrZeroDivisor
+	}
+	return z.Copy(p), nil
+}
+
+// Solve returns the solution x of the linear system ax = b, a DualVector
+// value. The dual parts of x are the derivatives of the solution along the
+// dual parts of a and b. If a is not square, or if the lengths do not match,
+// then Solve panics. If the determinant of a is a zero divisor, then Solve
+// returns ErrZeroDivisor.
+//
+// The system is solved by LU decomposition with partial pivoting on the real
+// parts, carried out in dual arithmetic.
+func Solve(a *DualMatrix, b DualVector) (DualVector, error) {
+	checkLen(a.rows, len(b))
+	lu, piv, _, ok := a.lu()
+	if !ok {
+		return nil, ErrZeroDivisor
+	}
+	n := a.rows
+	x := make(DualVector, n)
+	for i := range x {
+		x[i] = b[piv[i]]
+	}
+	t := new(Real)
+	// Forward substitution with the unit lower triangle.
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			x[i].Sub(&x[i], t.Mul(&lu.data[i*n+j], &x[j]))
+		}
+	}
+	// Back substitution with the upper triangle.
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			x[i].Sub(&x[i], t.Mul(&lu.data[i*n+j], &x[j]))
+		}
+		x[i].Quo(&x[i], &lu.data[i*n+i])
+	}
+	return x, nil
+}
+
+// inv returns the inverse of z, computed by Gauss-Jordan elimination. If a
+// pivot is a zero divisor, then ok is false.
+func (z *DualMatrix) inv() (p *DualMatrix, ok bool) {
+	if z.rows != z.cols {
+		panic("non-square matrix")
+	}
+	n := z.rows
+	a := new(DualMatrix).Copy(z)
+	p = NewDualIdentity(n)
+	f, t := new(Real), new(Real)
+	for k := 0; k < n; k++ {
+		r := a.pivot(k)
+		if a.data[r*n+k].IsZeroDiv() {
+			return nil, false
+		}
+		a.swapRows(k, r)
+		p.swapRows(k, r)
+		f.Inv(&a.data[k*n+k])
+		a.Row(k).Scale(a.Row(k), f)
+		p.Row(k).Scale(p.Row(k), f)
+		for i := 0; i < n; i++ {
+			if i == k {
+				continue
+			}
+			f.Copy(&a.data[i*n+k])
+			for j := 0; j < n; j++ {
+				a.data[i*n+j].Sub(&a.data[i*n+j], t.Mul(f, &a.data[k*n+j]))
+				p.data[i*n+j].Sub(&p.data[i*n+j], t.Mul(f, &p.data[k*n+j]))
+			}
+		}
+	}
+	return p, true
+}
+
+// lu returns the LU decomposition with partial pivoting of the square matrix
+// z, packed in a single matrix with the unit diagonal of L omitted, together
+// with the row permutation and its sign. If a pivot is a zero divisor, then ok
+// is false.
+func (z *DualMatrix) lu() (lu *DualMatrix, piv []int, sign float64, ok bool) {
+	if z.rows != z.cols {
+		panic("non-square matrix")
+	}
+	n := z.rows
+	lu = new(DualMatrix).Copy(z)
+	piv = make([]int, n)
+	for i := range piv {
+		piv[i] = i
+	}
+	sign = 1
+	f, t := new(Real), new(Real)
+	for k := 0; k < n; k++ {
+		r := lu.pivot(k)
+		if lu.data[r*n+k].IsZeroDiv() {
+			return nil, nil, 0, false
+		}
+		if r != k {
+			lu.swapRows(k, r)
+			piv[k], piv[r] = piv[r], piv[k]
+			sign = -sign
+		}
+		for i := k + 1; i < n; i++ {
+			f.Quo(&lu.data[i*n+k], &lu.data[k*n+k])
+			lu.data[i*n+k].Copy(f)
+			for j := k + 1; j < n; j++ {
+				lu.data[i*n+j].Sub(&lu.data[i*n+j], t.Mul(f, &lu.data[k*n+j]))
+			}
+		}
+	}
+	return lu, piv, sign, true
+}
+
+// detColumns returns the determinant of the square matrix z from real
+// determinants only. The real part is the determinant of the real parts A of
+// z, and the dual part is the sum over each column j of the determinant of A
+// with column j replaced by the matching column of the dual parts of z.
+func (z *DualMatrix) detColumns() *Real {
+	n := z.rows
+	a := make([]float64, n*n)
+	for i := range a {
+		a[i] = z.data[i].Real()
+	}
+	d := NewReal(detFloats(append([]float64(nil), a...), n), 0)
+	b := make([]float64, n*n)
+	for j := 0; j < n; j++ {
+		copy(b, a)
+		for i := 0; i < n; i++ {
+			b[i*n+j] = z.data[i*n+j].Dual()
+		}
+		d.SetDual(d.Dual() + detFloats(b, n))
+	}
+	return d
+}
+
+// detFloats returns the determinant of the n×n matrix a of float64 values in
+// row-major order, by Gaussian elimination with partial pivoting. The matrix a
+// is overwritten.
+func detFloats(a []float64, n int) float64 {
+	d := 1.0
+	for k := 0; k < n; k++ {
+		r := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(a[i*n+k]) > math.Abs(a[r*n+k]) {
+				r = i
+			}
+		}
+		if a[r*n+k] == 0 {
+			return 0
+		}
+		if r != k {
+			for j := 0; j < n; j++ {
+				a[k*n+j], a[r*n+j] = a[r*n+j], a[k*n+j]
+			}
+			d = -d
+		}
+		d *= a[k*n+k]
+		for i := k + 1; i < n; i++ {
+			f := a[i*n+k] / a[k*n+k]
+			for j := k + 1; j < n; j++ {
+				a[i*n+j] -= f * a[k*n+j]
+			}
+		}
+	}
+	return d
+}
+
+// pivot returns the index of the row, at or below row k, with the largest real
+// part in absolute value in column k.
+func (z *DualMatrix) pivot(k int) int {
+	r, m := k, -1.0
+	for i := k; i < z.rows; i++ {
+		if a := z.data[i*z.cols+k].Real(); a*a > m {
+			r, m = i, a*a
+		}
+	}
+	return r
+}
+
+// swapRows swaps rows i and j of z.
+func (z *DualMatrix) swapRows(i, j int) {
+	if i == j {
+		return
+	}
+	p, q := z.Row(i), z.Row(j)
+	for k := range p {
+		p[k], q[k] = q[k], p[k]
+	}
+}
+
+// reuse resizes z to r rows and c columns, reusing its storage if possible.
+func (z *DualMatrix) reuse(r, c int) {
+	z.rows, z.cols = r, c
+	if cap(z.data) < r*c {
+		z.data = make([]Real, r*c)
+	}
+	z.data = z.data[:r*c]
+}
+
+// checkDims panics if x and y do not have the same dimensions.
+func checkDims(x, y *DualMatrix) {
+	if x.rows != y.rows || x.cols != y.cols {
+		panic("mismatched dimensions")
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "testing"
+
+func TestDualMatrix(t *testing.T) {
+	a := NewDualMatrix(2, 2, []Real{{2, 1}, {1, 0}, {1, 0}, {3, 2}})
+	if got, want := a.Det(), NewReal(5, 7); !got.Equals(want) {
+		t.Errorf("Det = %v, want %v", got, want)
+	}
+	inv := new(DualMatrix).Inv(a)
+	if got := new(DualMatrix).Mul(a, inv); !got.Equals(NewDualIdentity(2)) {
+		t.Errorf("Mul(a, Inv(a)) = %v", got)
+	}
+	if got := new(DualMatrix).Mul(inv, a); !got.Equals(NewDualIdentity(2)) {
+		t.Errorf("Mul(Inv(a), a) = %v", got)
+	}
+	b := NewDualMatrix(2, 3, []Real{{1, 0}, {2, 0}, {3, 0}, {4, 0}, {5, 0}, {6, 1}})
+	bt := new(DualMatrix).Transpose(b)
+	if r, c := bt.Dims(); r != 3 || c != 2 || !bt.At(2, 1).Equals(NewReal(6, 1)) {
+		t.Errorf("Transpose = %v", bt)
+	}
+	// A singular real part still has a dual determinant.
+	s := NewDualMatrix(2, 2, []Real{{1, 1}, {2, 0}, {2, 0}, {4, 0}})
+	if got, want := s.Det(), NewReal(0, 4); !got.Equals(want) {
+		t.Errorf("Det = %v, want %v", got, want)
+	}
+	if _, err := new(DualMatrix).InvChecked(s); err != ErrZeroDivisor {
+		t.Errorf("InvChecked: err = %v, want %v", err, ErrZeroDivisor)
+	}
+}
+
+func TestSolve(t *testing.T) {
+	// The zero in the first row forces a row exchange.
+	a := NewDualMatrix(3, 3, []Real{
+		{0, 0}, {2, 1}, {1, 0},
+		{1, 0}, {1, 0}, {0, 2},
+		{4, 0}, {0, 0}, {1, 1},
+	})
+	b := DualVector{{3, 0}, {2, 1}, {5, 0}}
+	x, err := Solve(a, b)
+	if err != nil {
+		t.Fatalf("Solve: %v", err)
+	}
+	if got := make(DualVector, 3).MulVec(a, x); !got.Equals(b) {
+		t.Errorf("a·Solve(a, b) = %v, want %v", got, b)
+	}
+	if _, err := Solve(NewDualMatrix(2, 2, []Real{{1, 0}, {2, 0}, {2, 0}, {4, 3}}), b[:2]); err != ErrZeroDivisor {
+		t.Errorf("Solve singular: err = %v, want %v", err, ErrZeroDivisor)
+	}
+}
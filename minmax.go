@@ -0,0 +1,103 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "math"
+
+// The componentwise Min, Max, and Clamp methods treat a value as a point in the
+// space of its Cartesian components, as for a bounding box of sensitivities.
+// They follow math.Min and math.Max on each component, so a NaN component
+// gives a NaN result component. The MinReal, MaxReal, and ClampReal methods of
+// Real instead compare real parts only and keep the dual part that goes with
+// the selected real part, which is the derivative of the min, max, or clamp
+// function in automatic differentiation.
+
+// minFloats sets each element of v to the smaller of it and the matching
+// element of w, and returns v.
+func minFloats(v, w []float64) []float64 {
+	for i := range v {
+		v[i] = math.Min(v[i], w[i])
+	}
+	return v
+}
+
+// maxFloats sets each element of v to the larger of it and the matching element
+// of w, and returns v.
+func maxFloats(v, w []float64) []float64 {
+	for i := range v {
+		v[i] = math.Max(v[i], w[i])
+	}
+	return v
+}
+
+// Min sets each component of z equal to the smaller of the matching components
+// of x and y, and returns z.
+func (z *Real) Min(x, y *Real) *Real {
+	return z.Set(math.Min(x[0], y[0]), math.Min(x[1], y[1]))
+}
+
+// Max sets each component of z equal to the larger of the matching components
+// of x and y, and returns z.
+func (z *Real) Max(x, y *Real) *Real {
+	return z.Set(math.Max(x[0], y[0]), math.Max(x[1], y[1]))
+}
+
+// Clamp sets each component of z equal to the matching component of y clamped
+// to the interval between the matching components of lo and hi, and returns z.
+func (z *Real) Clamp(y, lo, hi *Real) *Real {
+	a := math.Min(math.Max(y[0], lo[0]), hi[0])
+	return z.Set(a, math.Min(math.Max(y[1], lo[1]), hi[1]))
+}
+
+// MinReal sets z equal to whichever of x and y has the smaller real part, and
+// returns z. If the real parts are equal, z is set equal to x.
+func (z *Real) MinReal(x, y *Real) *Real {
+	if y.Real() < x.Real() {
+		return z.Copy(y)
+	}
+	return z.Copy(x)
+}
+
+// MaxReal sets z equal to whichever of x and y has the larger real part, and
+// returns z. If the real parts are equal, z is set equal to x.
+func (z *Real) MaxReal(x, y *Real) *Real {
+	if y.Real() > x.Real() {
+		return z.Copy(y)
+	}
+	return z.Copy(x)
+}
+
+// ClampReal sets z equal to y with its real part clamped to [lo, hi], and
+// returns z. If the real part of y is outside the interval, the dual part of z
+// is zero, since a clamped value does not change with y.
+func (z *Real) ClampReal(y *Real, lo, hi float64) *Real {
+	switch a := y.Real(); {
+	case a < lo:
+		return z.Set(lo, 0)
+	case a > hi:
+		return z.Set(hi, 0)
+	}
+	return z.Copy(y)
+}
+
+// Min sets each component of z equal to the smaller of the matching components
+// of x and y, and returns z.
+func (z *Hamilton) Min(x, y *Hamilton) *Hamilton {
+	v := minFloats(x.components(), y.components())
+	return z.Set(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+}
+
+// Max sets each component of z equal to the larger of the matching components
+// of x and y, and returns z.
+func (z *Hamilton) Max(x, y *Hamilton) *Hamilton {
+	v := maxFloats(x.components(), y.components())
+	return z.Set(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+}
+
+// Clamp sets each component of z equal to the matching component of y clamped
+// to the interval between the matching components of lo and hi, and returns z.
+func (z *Hamilton) Clamp(y, lo, hi *Hamilton) *Hamilton {
+	v := minFloats(maxFloats(y.components(), lo.components()), hi.components())
+	return z.Set(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "testing"
+
+func TestMinMax(t *testing.T) {
+	x, y := NewReal(1, 5), NewReal(2, -3)
+	if got, want := new(Real).Min(x, y), NewReal(1, -3); !got.Equal(want) {
+		t.Errorf("Min = %v, want %v", got, want)
+	}
+	if got, want := new(Real).Max(x, y), NewReal(2, 5); !got.Equal(want) {
+		t.Errorf("Max = %v, want %v", got, want)
+	}
+	if got, want := new(Real).Clamp(NewReal(7, -7), NewReal(0, 0), NewReal(5, 5)), NewReal(5, 0); !got.Equal(want) {
+		t.Errorf("Clamp = %v, want %v", got, want)
+	}
+	if got := new(Real).MinReal(x, y); !got.Equal(x) {
+		t.Errorf("MinReal = %v, want %v", got, x)
+	}
+	if got := new(Real).MaxReal(x, y); !got.Equal(y) {
+		t.Errorf("MaxReal = %v, want %v", got, y)
+	}
+	for _, test := range []struct {
+		y, want *Real
+	}{
+		{NewReal(-1, 3), NewReal(0, 0)},
+		{NewReal(0.5, 3), NewReal(0.5, 3)},
+		{NewReal(2, 3), NewReal(1, 0)},
+	} {
+		if got := new(Real).ClampReal(test.y, 0, 1); !got.Equal(test.want) {
+			t.Errorf("ClampReal(%v) = %v, want %v", test.y, got, test.want)
+		}
+	}
+	p := NewHamilton(1, -1, 2, -2, 3, -3, 4, -4)
+	q := NewHamilton(0, 0, 0, 0, 0, 0, 0, 0)
+	if got, want := new(Hamilton).Min(p, q), NewHamilton(0, -1, 0, -2, 0, -3, 0, -4); !got.Equal(want) {
+		t.Errorf("Min = %v, want %v", got, want)
+	}
+	if got, want := new(Hamilton).Max(p, q), NewHamilton(1, 0, 2, 0, 3, 0, 4, 0); !got.Equal(want) {
+		t.Errorf("Max = %v, want %v", got, want)
+	}
+	lo, hi := NewHamilton(-1, -1, -1, -1, -1, -1, -1, -1), NewHamilton(2, 2, 2, 2, 2, 2, 2, 2)
+	if got, want := new(Hamilton).Clamp(p, lo, hi), NewHamilton(1, -1, 2, -1, 2, -1, 2, -1); !got.Equal(want) {
+		t.Errorf("Clamp = %v, want %v", got, want)
+	}
+}
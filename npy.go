@@ -0,0 +1,233 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	// npyMagic is the magic string at the start of a NumPy .npy file.
+	npyMagic = "\x93NUMPY"
+
+	// npyMaxHeader is the largest header length that readNpy accepts, well
+	// above the headers that NumPy writes.
+	npyMaxHeader = 1 << 16
+
+	// npyChunk is the number of float64 values that readNpy reads at a time,
+	// so that a header with a huge shape cannot force a huge allocation
+	// before the data is known to be there.
+	npyChunk = 1 << 16
+)
+
+// WriteRealNpy writes x to w in the NumPy .npy format, as a float64 array of
+// shape (len(x), 2). Column 0 is the real part and column 1 is the dual part.
+func WriteRealNpy(w io.Writer, x []Real) error {
+	v := make([]float64, 0, 2*len(x))
+	for i := range x {
+		v = append(v, x[i][0], x[i][1])
+	}
+	return writeNpy(w, v, len(x), 2)
+}
+
+// ReadRealNpy reads a float64 array of shape (N, 2) in the NumPy .npy format
+// from r, as written by WriteRealNpy.
+func ReadRealNpy(r io.Reader) ([]Real, error) {
+	v, n, err := readNpy(r, 2)
+	if err != nil {
+		return nil, err
+	}
+	x := make([]Real, n)
+	for i := range x {
+		x[i] = Real{v[2*i], v[2*i+1]}
+	}
+	return x, nil
+}
+
+// WriteHamiltonNpy writes x to w in the NumPy .npy format, as a float64 array
+// of shape (len(x), 8). The columns are the components in the order of the
+// Cartesian method: columns 0 through 3 are the real quaternion part (w, x,
+// y, z), and columns 4 through 7 are the dual quaternion part.
+func WriteHamiltonNpy(w io.Writer, x []Hamilton) error {
+	v := make([]float64, 0, 8*len(x))
+	for i := range x {
+		a, b, c, d, e, f, g, h := x[i].Cartesian()
+		v = append(v, a, b, c, d, e, f, g, h)
+	}
+	return writeNpy(w, v, len(x), 8)
+}
+
+// ReadHamiltonNpy reads a float64 array of shape (N, 8) in the NumPy .npy
+// format from r, as written by WriteHamiltonNpy.
+func ReadHamiltonNpy(r io.Reader) ([]Hamilton, error) {
+	v, n, err := readNpy(r, 8)
+	if err != nil {
+		return nil, err
+	}
+	x := make([]Hamilton, n)
+	for i := range x {
+		u := v[8*i : 8*i+8]
+		x[i] = *NewHamilton(u[0], u[1], u[2], u[3], u[4], u[5], u[6], u[7])
+	}
+	return x, nil
+}
+
+// writeNpy writes the row-major float64 array v of shape (n, m) to w in
+// version 1.0 of the .npy format, in little-endian byte order.
+func writeNpy(w io.Writer, v []float64, n, m int) error {
+	h := fmt.Sprintf("{'descr': '<f8', 'fortran_order': False, 'shape': (%d, %d), }", n, m)
+	// The magic string, version, header length, and header end with a
+	// newline at a multiple of 64 bytes.
+	pad := 64 - (len(npyMagic)+4+len(h)+1)%64
+	h += strings.Repeat(" ", pad%64) + "\n"
+	if len(h) > math.MaxUint16 {
+		return fmt.Errorf("dual: npy header of %d bytes", len(h))
+	}
+	bw := bufio.NewWriter(w)
+	bw.WriteString(npyMagic)
+	bw.Write([]byte{1, 0})
+	binary.Write(bw, binary.LittleEndian, uint16(len(h)))
+	bw.WriteString(h)
+	var b [8]byte
+	for _, x := range v {
+		binary.LittleEndian.PutUint64(b[:], math.Float64bits(x))
+		bw.Write(b[:])
+	}
+	return bw.Flush()
+}
+
+// readNpy reads a float64 array of shape (n, m) in the .npy format from r, and
+// returns its values in row-major order together with n.
+func readNpy(r io.Reader, m int) ([]float64, int, error) {
+	var pre [8]byte
+	if _, err := io.ReadFull(r, pre[:]); err != nil {
+		return nil, 0, err
+	}
+	if string(pre[:6]) != npyMagic {
+		return nil, 0, npyError("not an npy file")
+	}
+	var hlen int
+	switch pre[6] {
+	case 1:
+		var l uint16
+		if err := binary.Read(r, binary.LittleEndian, &l); err != nil {
+			return nil, 0, err
+		}
+		hlen = int(l)
+	case 2, 3:
+		var l uint32
+		if err := binary.Read(r, binary.LittleEndian, &l); err != nil {
+			return nil, 0, err
+		}
+		hlen = int(l)
+	default:
+		return nil, 0, npyError(fmt.Sprintf("unsupported version %d.%d", pre[6], pre[7]))
+	}
+	if hlen > npyMaxHeader {
+		return nil, 0, npyError(fmt.Sprintf("header length %d", hlen))
+	}
+	hb := make([]byte, hlen)
+	if _, err := io.ReadFull(r, hb); err != nil {
+		return nil, 0, err
+	}
+	h := string(hb)
+	var order binary.ByteOrder
+	switch descr := npyField(h, "descr"); descr {
+	case "'<f8'", "'f8'", "'float64'":
+		order = binary.LittleEndian
+	case "'>f8'":
+		order = binary.BigEndian
+	default:
+		return nil, 0, npyError("dtype " + descr + ", want '<f8'")
+	}
+	if f := npyField(h, "fortran_order"); f != "False" {
+		return nil, 0, npyError("fortran_order " + f)
+	}
+	n, err := npyShape(npyField(h, "shape"), m)
+	if err != nil {
+		return nil, 0, err
+	}
+	total := n * m
+	v := make([]float64, 0, min(total, npyChunk))
+	b := make([]byte, 8*min(total, npyChunk))
+	for len(v) < total {
+		c := b[:8*min(total-len(v), npyChunk)]
+		if _, err := io.ReadFull(r, c); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, 0, err
+		}
+		for i := 0; i < len(c); i += 8 {
+			v = append(v, math.Float64frombits(order.Uint64(c[i:])))
+		}
+	}
+	return v, n, nil
+}
+
+// npyField returns the value of key in the header dictionary h, or the empty
+// string if there is no such key. The value ends at the first comma that is
+// not inside parentheses.
+func npyField(h, key string) string {
+	i := strings.Index(h, "'"+key+"'")
+	if i < 0 {
+		return ""
+	}
+	s := strings.TrimSpace(h[i+len(key)+2:])
+	s, ok := strings.CutPrefix(s, ":")
+	if !ok {
+		return ""
+	}
+	s = strings.TrimSpace(s)
+	depth := 0
+	for j, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',', '}':
+			if depth == 0 {
+				return strings.TrimSpace(s[:j])
+			}
+		}
+	}
+	return strings.TrimSpace(s)
+}
+
+// npyShape parses the shape s, which must be (n, m), and returns n.
+func npyShape(s string, m int) (int, error) {
+	t, ok1 := strings.CutPrefix(s, "(")
+	t, ok2 := strings.CutSuffix(t, ")")
+	if !ok1 || !ok2 {
+		return 0, npyError("shape " + s)
+	}
+	dims := strings.Split(t, ",")
+	if len(dims) != 2 {
+		return 0, npyError(fmt.Sprintf("shape %s, want (N, %d)", s, m))
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(dims[0]))
+	if err != nil || n < 0 {
+		return 0, npyError("shape " + s)
+	}
+	if n > math.MaxInt/(8*m) {
+		return 0, npyError("shape " + s + " too large")
+	}
+	if k, err := strconv.Atoi(strings.TrimSpace(dims[1])); err != nil || k != m {
+		return 0, npyError(fmt.Sprintf("shape %s, want (N, %d)", s, m))
+	}
+	return n, nil
+}
+
+// npyError returns the error for a malformed or unsupported .npy file,
+// described by msg.
+func npyError(msg string) error {
+	return fmt.Errorf("dual: reading npy: %s", msg)
+}
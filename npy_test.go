@@ -0,0 +1,150 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestRealNpy(t *testing.T) {
+	x := []Real{{1, 2}, {-3.5, 4}, {0, -6}}
+	var buf bytes.Buffer
+	if err := WriteRealNpy(&buf, x); err != nil {
+		t.Fatal(err)
+	}
+	b := buf.Bytes()
+	hlen := int(binary.LittleEndian.Uint16(b[8:10]))
+	if (10+hlen)%64 != 0 || b[10+hlen-1] != '\n' {
+		t.Errorf("header length %d is not aligned", hlen)
+	}
+	if h := string(b[10 : 10+hlen]); !strings.Contains(h, "'shape': (3, 2)") {
+		t.Errorf("header = %q", h)
+	}
+	if len(b) != 10+hlen+8*6 {
+		t.Errorf("len = %d, want %d", len(b), 10+hlen+8*6)
+	}
+	y, err := ReadRealNpy(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(y) != len(x) {
+		t.Fatalf("len = %d, want %d", len(y), len(x))
+	}
+	for i := range x {
+		if y[i] != x[i] {
+			t.Errorf("ReadRealNpy()[%d] = %v, want %v", i, y[i], x[i])
+		}
+	}
+}
+
+func TestHamiltonNpy(t *testing.T) {
+	x := []Hamilton{
+		*NewHamilton(1, 2, 3, 4, 5, 6, 7, 8),
+		*NewHamilton(0, -1, 0.5, 0, 2, 0, 0, -3),
+	}
+	var buf bytes.Buffer
+	if err := WriteHamiltonNpy(&buf, x); err != nil {
+		t.Fatal(err)
+	}
+	y, err := ReadHamiltonNpy(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range x {
+		if !y[i].Equals(&x[i]) {
+			t.Errorf("ReadHamiltonNpy()[%d] = %v, want %v", i, &y[i], &x[i])
+		}
+	}
+}
+
+func TestReadNpyBigEndian(t *testing.T) {
+	h := "{'descr': '>f8', 'fortran_order': False, 'shape': (1, 2), }\n"
+	var buf bytes.Buffer
+	buf.WriteString("\x93NUMPY\x01\x00")
+	binary.Write(&buf, binary.LittleEndian, uint16(len(h)))
+	buf.WriteString(h)
+	binary.Write(&buf, binary.BigEndian, []float64{1.5, -2})
+	x, err := ReadRealNpy(&buf)
+	if err != nil || len(x) != 1 || x[0] != (Real{1.5, -2}) {
+		t.Errorf("ReadRealNpy = %v, %v, want [(1.5-2ε)]", x, err)
+	}
+}
+
+func TestReadNpyError(t *testing.T) {
+	var buf bytes.Buffer
+	WriteRealNpy(&buf, []Real{{1, 2}})
+	if _, err := ReadHamiltonNpy(bytes.NewReader(buf.Bytes())); err == nil {
+		t.Error("ReadHamiltonNpy of shape (1, 2): no error")
+	}
+	for _, h := range []string{
+		"{'descr': '<f4', 'fortran_order': False, 'shape': (1, 2), }\n",
+		"{'descr': '<f8', 'fortran_order': True, 'shape': (1, 2), }\n",
+		"{'descr': '<f8', 'fortran_order': False, 'shape': (2,), }\n",
+	} {
+		var buf bytes.Buffer
+		buf.WriteString("\x93NUMPY\x01\x00")
+		binary.Write(&buf, binary.LittleEndian, uint16(len(h)))
+		buf.WriteString(h)
+		buf.Write(make([]byte, 16))
+		if _, err := ReadRealNpy(&buf); err == nil {
+			t.Errorf("ReadRealNpy with header %q: no error", h)
+		}
+	}
+	if _, err := ReadRealNpy(strings.NewReader("PK\x03\x04 not npy")); err == nil {
+		t.Error("ReadRealNpy of a non-npy file: no error")
+	}
+	// A huge shape is an error, and a shape larger than the data is a short
+	// read, without allocating for the whole shape first.
+	for _, test := range []struct {
+		shape string
+		want  error
+	}{
+		{"(1000000000000000, 2)", nil},
+		{"(9223372036854775807, 2)", nil},
+		{"(100000000, 2)", io.ErrUnexpectedEOF},
+	} {
+		h := "{'descr': '<f8', 'fortran_order': False, 'shape': " + test.shape + ", }\n"
+		var buf bytes.Buffer
+		buf.WriteString("\x93NUMPY\x01\x00")
+		binary.Write(&buf, binary.LittleEndian, uint16(len(h)))
+		buf.WriteString(h)
+		buf.Write(make([]byte, 16))
+		_, err := ReadRealNpy(&buf)
+		if err == nil || (test.want != nil && !errors.Is(err, test.want)) {
+			t.Errorf("ReadRealNpy with shape %s: error %v, want %v", test.shape, err, test.want)
+		}
+	}
+	buf.Reset()
+	buf.WriteString("\x93NUMPY\x02\x00")
+	binary.Write(&buf, binary.LittleEndian, uint32(1<<30))
+	if _, err := ReadRealNpy(&buf); err == nil {
+		t.Error("ReadRealNpy with a 1 GiB header: no error")
+	}
+}
+
+func TestReadNpyChunks(t *testing.T) {
+	// More values than one chunk of readNpy.
+	x := make([]Real, npyChunk+3)
+	for i := range x {
+		x[i] = Real{float64(i), -float64(i)}
+	}
+	var buf bytes.Buffer
+	if err := WriteRealNpy(&buf, x); err != nil {
+		t.Fatal(err)
+	}
+	y, err := ReadRealNpy(&buf)
+	if err != nil || len(y) != len(x) || y[len(y)-1] != x[len(x)-1] {
+		t.Fatalf("ReadRealNpy = %d values, %v, want %d", len(y), err, len(x))
+	}
+	for i := range x {
+		if y[i] != x[i] {
+			t.Fatalf("y[%d] = %v, want %v", i, &y[i], &x[i])
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"runtime"
+	"sync"
+)
+
+// minChunk is the smallest number of elements handed to one goroutine by the
+// parallel helpers. Shorter slices are processed on the calling goroutine.
+const minChunk = 256
+
+// RealParallelMap calls f(&dst[i], &src[i]) for each index i, splitting the
+// indices among up to GOMAXPROCS goroutines, and returns once every call has
+// returned. The function f should set its first argument from its second, and
+// must be safe to call concurrently. If dst and src do not have the same
+// length, then RealParallelMap panics.
+func RealParallelMap(dst, src []Real, f func(z, x *Real)) {
+	checkLen(len(dst), len(src))
+	parallel(len(dst), func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			f(&dst[i], &src[i])
+		}
+	})
+}
+
+// RealParallelTransform calls f(&s[i]) for each index i, splitting the indices
+// among up to GOMAXPROCS goroutines, and returns once every call has returned.
+// The function f should update its argument in place, and must be safe to call
+// concurrently.
+func RealParallelTransform(s []Real, f func(z *Real)) {
+	parallel(len(s), func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			f(&s[i])
+		}
+	})
+}
+
+// HamiltonParallelMap calls f(&dst[i], &src[i]) for each index i, splitting
+// the indices among up to GOMAXPROCS goroutines, and returns once every call
+// has returned. The function f should set its first argument from its second,
+// and must be safe to call concurrently. If dst and src do not have the same
+// length, then HamiltonParallelMap panics.
+func HamiltonParallelMap(dst, src []Hamilton, f func(z, x *Hamilton)) {
+	checkLen(len(dst), len(src))
+	parallel(len(dst), func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			f(&dst[i], &src[i])
+		}
+	})
+}
+
+// HamiltonParallelTransform calls f(&s[i]) for each index i, splitting the
+// indices among up to GOMAXPROCS goroutines, and returns once every call has
+// returned. The function f should update its argument in place, and must be
+// safe to call concurrently.
+func HamiltonParallelTransform(s []Hamilton, f func(z *Hamilton)) {
+	parallel(len(s), func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			f(&s[i])
+		}
+	})
+}
+
+// parallel splits the range [0, n) into contiguous chunks of at least minChunk
+// indices, calls f on each chunk in its own goroutine, and waits for all of
+// them. The last chunk runs on the calling goroutine.
+func parallel(n int, f func(lo, hi int)) {
+	k := runtime.GOMAXPROCS(0)
+	if m := n / minChunk; m < k {
+		k = m
+	}
+	if k <= 1 {
+		f(0, n)
+		return
+	}
+	var wg sync.WaitGroup
+	size := (n + k - 1) / k
+	lo := 0
+	for ; lo+size < n; lo += size {
+		wg.Add(1)
+		go func(lo, hi int) {
+			defer wg.Done()
+			f(lo, hi)
+		}(lo, lo+size)
+	}
+	f(lo, n)
+	wg.Wait()
+}
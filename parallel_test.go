@@ -0,0 +1,63 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestParallel(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+	for _, n := range []int{0, 1, minChunk - 1, minChunk, 10*minChunk + 3} {
+		seen := make([]int, n)
+		parallel(n, func(lo, hi int) {
+			for i := lo; i < hi; i++ {
+				seen[i]++
+			}
+		})
+		for i, c := range seen {
+			if c != 1 {
+				t.Fatalf("n = %d: index %d visited %d times", n, i, c)
+			}
+		}
+	}
+}
+
+func TestRealParallelMap(t *testing.T) {
+	const n = 3*minChunk + 1
+	src, dst := make([]Real, n), make([]Real, n)
+	for i := range src {
+		src[i] = Real{float64(i), 1}
+	}
+	RealParallelMap(dst, src, func(z, x *Real) { z.Mul(x, x) })
+	for i := range dst {
+		if want := new(Real).Mul(&src[i], &src[i]); !dst[i].Equals(want) {
+			t.Fatalf("dst[%d] = %v, want %v", i, &dst[i], want)
+		}
+	}
+	RealParallelTransform(dst, func(z *Real) { z.Neg(z) })
+	if want := NewReal(-4, -4); !dst[2].Equals(want) {
+		t.Errorf("dst[2] = %v, want %v", &dst[2], want)
+	}
+}
+
+func TestHamiltonParallelMap(t *testing.T) {
+	const n = 2*minChunk + 5
+	q := NewHamilton(0, 1, 0, 0, 0, 0, 1, 0)
+	src, dst := make([]Hamilton, n), make([]Hamilton, n)
+	for i := range src {
+		src[i] = *NewHamilton(1, 0, 0, 0, 0, float64(i), 0, 0)
+	}
+	HamiltonParallelMap(dst, src, func(z, x *Hamilton) { z.Mul(q, x) })
+	for i := range dst {
+		if want := new(Hamilton).Mul(q, &src[i]); !dst[i].Equals(want) {
+			t.Fatalf("dst[%d] = %v, want %v", i, &dst[i], want)
+		}
+	}
+	HamiltonParallelTransform(dst, func(z *Hamilton) { z.Conj(z) })
+	if want := new(Hamilton).Conj(new(Hamilton).Mul(q, &src[7])); !dst[7].Equals(want) {
+		t.Errorf("dst[7] = %v, want %v", &dst[7], want)
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// Accepted spellings of the canonical basis symbols of each type.
+	parseSymbReal     = parseSymbols(symbReal[:], symbRealASCII[:])
+	parseSymbComplex  = parseSymbols(symbComplex[:], symbComplexASCII[:])
+	parseSymbPerplex  = parseSymbols(symbPerplex[:], symbPerplexASCII[:])
+	parseSymbHamilton = parseSymbols(symbHamilton[:], symbHamiltonASCII[:])
+	parseSymbHyper    = parseSymbols(symbHyper[:], symbHyperASCII[:])
+	parseSymbSuper    = parseSymbols(symbSuper[:], symbSuperASCII[:])
+	parseSymbUltra    = parseSymbols(symbUltra[:], symbUltraASCII[:])
+)
+
+// ParseReal returns a pointer to the Real value represented by s.
+//
+// The string s is a sum of terms, each one a float64 coefficient followed by a
+// basis symbol, as produced by String. The enclosing parentheses are optional,
+// spaces are allowed between terms, a "*" is allowed between a coefficient and
+// its symbol, and the symbol "eps" is accepted in place of "ε". A symbol without
+// a coefficient has coefficient 1. For example, "(3+4ε)", "3 + 4eps", and
+// "-2.5e-3ε" are all valid.
+func ParseReal(s string) (*Real, error) {
+	v, err := parseComponents(s, parseSymbReal)
+	if err != nil {
+		return nil, err
+	}
+	return NewReal(v[0], v[1]), nil
+}
+
+// ParseComplex returns a pointer to the Complex value represented by s.
+//
+// The syntax is the same as for ParseReal, with the basis symbols of String.
+// The terms can appear in any order, and omitted terms are zero. The symbols
+// "eps" and "epsi" are accepted in place of "ε" and "εi".
+func ParseComplex(s string) (*Complex, error) {
+	v, err := parseComponents(s, parseSymbComplex)
+	if err != nil {
+		return nil, err
+	}
+	return NewComplex(v[0], v[1], v[2], v[3]), nil
+}
+
+// ParsePerplex returns a pointer to the Perplex value represented by s.
+//
+// The syntax is the same as for ParseReal, with the basis symbols of String.
+// The terms can appear in any order, and omitted terms are zero. The symbols
+// "eps" and "epss" are accepted in place of "ε" and "εs".
+func ParsePerplex(s string) (*Perplex, error) {
+	v, err := parseComponents(s, parseSymbPerplex)
+	if err != nil {
+		return nil, err
+	}
+	return NewPerplex(v[0], v[1], v[2], v[3]), nil
+}
+
+// ParseHamilton returns a pointer to the Hamilton value represented by s.
+//
+// The syntax is the same as for ParseReal, with the basis symbols of String.
+// The terms can appear in any order, and omitted terms are zero. The symbols
+// "eps", "epsi", "epsj", and "epsk" are accepted in place of "ε", "εi", "εj",
+// and "εk".
+func ParseHamilton(s string) (*Hamilton, error) {
+	v, err := parseComponents(s, parseSymbHamilton)
+	if err != nil {
+		return nil, err
+	}
+	return NewHamilton(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7]), nil
+}
+
+// ParseHyper returns a pointer to the Hyper value represented by s.
+//
+// The syntax is the same as for ParseReal, with the basis symbols of String.
+// The terms can appear in any order, and omitted terms are zero. The symbols
+// "eps", "eta", and "epseta" are accepted in place of "ε", "η", and "εη".
+func ParseHyper(s string) (*Hyper, error) {
+	v, err := parseComponents(s, parseSymbHyper)
+	if err != nil {
+		return nil, err
+	}
+	return NewHyper(v[0], v[1], v[2], v[3]), nil
+}
+
+// ParseSuper returns a pointer to the Super value represented by s.
+//
+// The syntax is the same as for ParseReal, with the basis symbols of String.
+// The terms can appear in any order, and omitted terms are zero. The symbols
+// "s1", "s2", and "s3" are accepted in place of "σ", "τ", and "στ".
+func ParseSuper(s string) (*Super, error) {
+	v, err := parseComponents(s, parseSymbSuper)
+	if err != nil {
+		return nil, err
+	}
+	return NewSuper(v[0], v[1], v[2], v[3]), nil
+}
+
+// ParseUltra returns a pointer to the Ultra value represented by s.
+//
+// The syntax is the same as for ParseReal, with the basis symbols of String.
+// The terms can appear in any order, and omitted terms are zero. The symbols
+// "u1" through "u7" are accepted in place of "υ₁" through "υ₇".
+func ParseUltra(s string) (*Ultra, error) {
+	v, err := parseComponents(s, parseSymbUltra)
+	if err != nil {
+		return nil, err
+	}
+	return NewUltra(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7]), nil
+}
+
+// parseSymbols returns the accepted spellings of each basis symbol, made from
+// the Unicode symbols u and the ASCII symbols a.
+func parseSymbols(u, a []string) [][]string {
+	symb := make([][]string, len(u))
+	for i := range u {
+		symb[i] = []string{u[i], a[i]}
+	}
+	return symb
+}
+
+// parseError returns the error for a failure to parse s, described by msg.
+func parseError(s, msg string) error {
+	return fmt.Errorf("dual: parsing %q: %s", s, msg)
+}
+
+// parseComponents parses s as a sum of terms and returns their coefficients in
+// the order of the basis symbols in symb. Each element of symb lists the
+// accepted spellings of one basis symbol, with the empty symbol for the real
+// component. The terms can appear in any order, and omitted terms are zero.
+func parseComponents(s string, symb [][]string) ([]float64, error) {
+	t := strings.TrimSpace(s)
+	if strings.HasPrefix(t, "(") && strings.HasSuffix(t, ")") {
+		t = strings.TrimSpace(t[1 : len(t)-1])
+	}
+	if t == "" {
+		return nil, parseError(s, "empty value")
+	}
+	v := make([]float64, len(symb))
+	seen := make([]bool, len(symb))
+	for first := true; t != ""; first = false {
+		neg := false
+		switch t[0] {
+		case '+':
+			t = strings.TrimSpace(t[1:])
+		case '-':
+			neg = true
+			t = strings.TrimSpace(t[1:])
+		default:
+			if !first {
+				return nil, parseError(s, "missing sign before "+strconv.Quote(t))
+			}
+		}
+		a, n := scanFloat(t)
+		coef := n > 0
+		t = strings.TrimSpace(t[n:])
+		if coef && strings.HasPrefix(t, "*") {
+			t = strings.TrimSpace(t[1:])
+		}
+		i, n := scanSymbol(t, symb)
+		t = strings.TrimSpace(t[n:])
+		if !coef && i == 0 {
+			if t == "" {
+				return nil, parseError(s, "missing term")
+			}
+			r, _ := utf8.DecodeRuneInString(t)
+			return nil, parseError(s, "unexpected "+strconv.QuoteRune(r))
+		}
+		if !coef {
+			a = 1
+		}
+		if t != "" && t[0] != '+' && t[0] != '-' {
+			r, _ := utf8.DecodeRuneInString(t)
+			return nil, parseError(s, "unexpected "+strconv.QuoteRune(r))
+		}
+		if seen[i] {
+			return nil, parseError(s, "repeated term")
+		}
+		seen[i] = true
+		if neg {
+			a = -a
+		}
+		v[i] = a
+	}
+	return v, nil
+}
+
+// scanFloat returns the unsigned float64 value at the start of t and the
+// number of bytes it occupies. If t does not start with a number, then
+// scanFloat returns zero bytes.
+func scanFloat(t string) (float64, int) {
+	for _, w := range []string{"infinity", "inf", "nan"} {
+		if len(t) >= len(w) && strings.EqualFold(t[:len(w)], w) {
+			a, _ := strconv.ParseFloat(t[:len(w)], 64)
+			return a, len(w)
+		}
+	}
+	n := 0
+	for n < len(t) && (isDigit(t[n]) || t[n] == '.') {
+		n++
+	}
+	if n == 0 {
+		return 0, 0
+	}
+	// An exponent must have digits, so that "4eps" reads as 4 and "eps".
+	if n < len(t) && (t[n] == 'e' || t[n] == 'E') {
+		m := n + 1
+		if m < len(t) && (t[m] == '+' || t[m] == '-') {
+			m++
+		}
+		if m < len(t) && isDigit(t[m]) {
+			for m < len(t) && isDigit(t[m]) {
+				m++
+			}
+			n = m
+		}
+	}
+	a, err := strconv.ParseFloat(t[:n], 64)
+	if err != nil {
+		return 0, 0
+	}
+	return a, n
+}
+
+// scanSymbol returns the index of the longest basis symbol in symb that starts
+// t, and the number of bytes it occupies. If no symbol matches, then
+// scanSymbol returns the index of the real component and zero bytes.
+func scanSymbol(t string, symb [][]string) (int, int) {
+	i, n := 0, 0
+	for j := range symb {
+		for _, w := range symb[j] {
+			if len(w) > n && strings.HasPrefix(t, w) {
+				i, n = j, len(w)
+			}
+		}
+	}
+	return i, n
+}
+
+// isDigit returns true if c is a decimal digit.
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
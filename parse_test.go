@@ -0,0 +1,195 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestParseReal(t *testing.T) {
+	var tests = []struct {
+		s    string
+		want *Real
+	}{
+		{"(0+0ε)", zeroR},
+		{"(1+0ε)", oneR},
+		{"(0+1ε)", epsiR},
+		{"(-1-1ε)", &Real{-1, -1}},
+		{"3+4ε", &Real{3, 4}},
+		{"3 + 4eps", &Real{3, 4}},
+		{"-2.5e-3ε", &Real{0, -2.5e-3}},
+		{"4e+2eps - 1", &Real{-1, 400}},
+		{"-ε", &Real{0, -1}},
+		{"2 * ε + 7", &Real{7, 2}},
+	}
+	for _, test := range tests {
+		got, err := ParseReal(test.s)
+		if err != nil {
+			t.Errorf("ParseReal(%q) error: %v", test.s, err)
+			continue
+		}
+		if !got.Equals(test.want) {
+			t.Errorf("ParseReal(%q) = %v, want %v", test.s, got, test.want)
+		}
+	}
+	for _, s := range []string{"", "()", "3+", "3 4ε", "1+2ε+3ε", "1+2x"} {
+		if _, err := ParseReal(s); err == nil {
+			t.Errorf("ParseReal(%q) did not fail", s)
+		}
+	}
+	for _, z := range []*Real{RealInf(+1, -1), RealNaN(), &Real{1e-300, -6.02e23}} {
+		got, err := ParseReal(z.String())
+		if err != nil || got.String() != z.String() {
+			t.Errorf("ParseReal(%q) = %v, %v", z.String(), got, err)
+		}
+	}
+}
+
+// parser turns a Parse function into one that returns a fmt.Stringer, so that
+// the tests of every type fit in one table.
+func parser[T fmt.Stringer](parse func(string) (T, error)) func(string) (fmt.Stringer, error) {
+	return func(s string) (fmt.Stringer, error) {
+		z, err := parse(s)
+		if err != nil {
+			return nil, err
+		}
+		return z, nil
+	}
+}
+
+func TestParse(t *testing.T) {
+	var (
+		parseComplex  = parser(ParseComplex)
+		parsePerplex  = parser(ParsePerplex)
+		parseHamilton = parser(ParseHamilton)
+		parseHyper    = parser(ParseHyper)
+		parseSuper    = parser(ParseSuper)
+		parseUltra    = parser(ParseUltra)
+	)
+	tests := []struct {
+		name  string
+		parse func(string) (fmt.Stringer, error)
+		s     string
+		want  fmt.Stringer
+	}{
+		{"Complex", parseComplex, "(1+2i-3ε+4εi)", NewComplex(1, 2, -3, 4)},
+		{"Complex", parseComplex, "4epsi - 3eps + 2i + 1", NewComplex(1, 2, -3, 4)},
+		{"Complex", parseComplex, "εi", NewComplex(0, 0, 0, 1)},
+		{"Perplex", parsePerplex, "(1-2s+3ε-4εs)", NewPerplex(1, -2, 3, -4)},
+		{"Perplex", parsePerplex, "3 * eps + 1", NewPerplex(1, 0, 3, 0)},
+		{"Hamilton", parseHamilton, "(1+2i+3j+4k+5ε+6εi+7εj+8εk)", NewHamilton(1, 2, 3, 4, 5, 6, 7, 8)},
+		{"Hamilton", parseHamilton, "8epsk + 2i - k + 5", NewHamilton(5, 2, 0, -1, 0, 0, 0, 8)},
+		{"Hyper", parseHyper, "(1+2ε+3η+4εη)", NewHyper(1, 2, 3, 4)},
+		{"Hyper", parseHyper, "epseta - eta", NewHyper(0, 0, -1, 1)},
+		{"Super", parseSuper, "(1+2σ+3τ+4στ)", NewSuper(1, 2, 3, 4)},
+		{"Super", parseSuper, "2.5s3 + s1", NewSuper(0, 1, 0, 2.5)},
+		{"Ultra", parseUltra, "(1+2υ₁+3υ₂+4υ₃+5υ₄+6υ₅+7υ₆+8υ₇)", NewUltra(1, 2, 3, 4, 5, 6, 7, 8)},
+		{"Ultra", parseUltra, "-u7 + 3u2 + 0.5", NewUltra(0.5, 0, 3, 0, 0, 0, 0, -1)},
+	}
+	for _, test := range tests {
+		got, err := test.parse(test.s)
+		if err != nil {
+			t.Errorf("Parse%s(%q) error: %v", test.name, test.s, err)
+			continue
+		}
+		if got.String() != test.want.String() {
+			t.Errorf("Parse%s(%q) = %v, want %v", test.name, test.s, got, test.want)
+		}
+		// String and Parse are inverses.
+		if z, err := test.parse(test.want.String()); err != nil || z.String() != test.want.String() {
+			t.Errorf("Parse%s(%q) = %v, %v", test.name, test.want.String(), z, err)
+		}
+	}
+	for _, test := range []struct {
+		name  string
+		parse func(string) (fmt.Stringer, error)
+		s     string
+	}{
+		{"Complex", parseComplex, ""},
+		{"Complex", parseComplex, "1+2i+3i"},
+		{"Complex", parseComplex, "1+2j"},
+		{"Perplex", parsePerplex, "(1+2s"},
+		{"Perplex", parsePerplex, "1+2i"},
+		{"Hamilton", parseHamilton, "1 2i"},
+		{"Hamilton", parseHamilton, "1+2ε+"},
+		{"Hyper", parseHyper, "1+2ηε"},
+		{"Super", parseSuper, "1+2σ+3σ"},
+		{"Super", parseSuper, "1+2s4"},
+		{"Ultra", parseUltra, "1+2υ₈"},
+		{"Ultra", parseUltra, "()"},
+	} {
+		if z, err := test.parse(test.s); err == nil {
+			t.Errorf("Parse%s(%q) = %v, want an error", test.name, test.s, z)
+		}
+	}
+}
+
+func TestMarshalText(t *testing.T) {
+	// A struct of every type, as in a configuration file.
+	type config struct {
+		R Real
+		C Complex
+		P Perplex
+		H Hamilton
+		Y Hyper
+		S Super
+		U Ultra
+	}
+	x := config{
+		R: *NewReal(1, -2),
+		C: *NewComplex(1, 2, 3, 4),
+		P: *NewPerplex(-1, 0.5, 0, 2),
+		H: *NewHamilton(1, 0, 0, 0, 0, 0.5, -1, 2),
+		Y: *NewHyper(2, 1, 1, 0),
+		S: *NewSuper(1, 2, 3, 4),
+		U: *NewUltra(1, 2, 3, 4, 5, 6, 7, 8),
+	}
+	b, err := json.Marshal(&x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"R":"(1-2ε)"`) {
+		t.Errorf("json.Marshal = %s", b)
+	}
+	// A struct passed by value is not addressable, so this needs MarshalText
+	// on the value receiver.
+	if c, err := json.Marshal(x); err != nil || string(c) != string(b) {
+		t.Errorf("json.Marshal by value = %s, %v, want %s", c, err, b)
+	}
+	if c, err := json.Marshal([]Real{*NewReal(1, 2)}); err != nil || string(c) != `["(1+2ε)"]` {
+		t.Errorf("json.Marshal([]Real) = %s, %v", c, err)
+	}
+	var y config
+	if err := json.Unmarshal(b, &y); err != nil {
+		t.Fatal(err)
+	}
+	if !y.R.Equals(&x.R) || !y.C.Equals(&x.C) || !y.P.Equals(&x.P) || !y.H.Equals(&x.H) ||
+		!y.Y.Equals(&x.Y) || !y.S.Equals(&x.S) || !y.U.Equals(&x.U) {
+		t.Errorf("json.Unmarshal = %+v, want %+v", y, x)
+	}
+	c, err := yaml.Marshal(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(c), "r: (1-2ε)\n") {
+		t.Errorf("yaml.Marshal = %s", c)
+	}
+	var w config
+	if err := yaml.Unmarshal(c, &w); err != nil {
+		t.Fatal(err)
+	}
+	if !w.R.Equals(&x.R) || !w.C.Equals(&x.C) || !w.P.Equals(&x.P) || !w.H.Equals(&x.H) ||
+		!w.Y.Equals(&x.Y) || !w.S.Equals(&x.S) || !w.U.Equals(&x.U) {
+		t.Errorf("yaml.Unmarshal = %+v, want %+v", w, x)
+	}
+	z := NewReal(3, 4)
+	if err := z.UnmarshalText([]byte("3+")); err == nil || !z.Equals(NewReal(3, 4)) {
+		t.Errorf("UnmarshalText(%q) = %v, %v", "3+", z, err)
+	}
+}
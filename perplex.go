@@ -6,7 +6,6 @@ package dual
 import (
 	"fmt"
 	"math"
-	"strings"
 
 	"github.com/meirizarrygelpi/split"
 )
@@ -18,6 +17,12 @@ type Perplex [2]*split.Complex
 var (
 	// Symbols for the canonical dual perplex basis.
 	symbPerplex = [4]string{"", "s", "ε", "εs"}
+
+	// ASCII symbols for the canonical dual perplex basis.
+	symbPerplexASCII = [4]string{"", "s", "eps", "epss"}
+
+	// LaTeX symbols for the canonical dual perplex basis.
+	symbPerplexLatex = [4]string{"", "s", `\varepsilon`, `\varepsilon s`}
 )
 
 // Real returns the real part of z, a pointer to a split.Complex value.
@@ -47,39 +52,136 @@ func (z *Perplex) Cartesian() (a, b, c, d float64) {
 	return
 }
 
+// components returns the four Cartesian components of z as a slice.
+func (z *Perplex) components() []float64 {
+	v := make([]float64, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	return v
+}
+
+// Set sets the four Cartesian components of z, in the order returned by
+// Cartesian, and returns z.
+func (z *Perplex) Set(a, b, c, d float64) *Perplex {
+	z.SetReal(split.New(a, b))
+	z.SetDual(split.New(c, d))
+	return z
+}
+
 // String returns the string representation of a Perplex value.
 //
 // If z corresponds to the dual perplex number a + bs + cε + dεs, then the
 // string is "(a+bs+cε+dεs)", similar to complex128 values.
 func (z *Perplex) String() string {
-	v := make([]float64, 4)
+	return string(z.AppendString(nil))
+}
+
+// AppendString appends the string version of z, as returned by String, to dst
+// and returns the extended buffer.
+func (z *Perplex) AppendString(dst []byte) []byte {
+	var v [4]float64
 	v[0], v[1], v[2], v[3] = z.Cartesian()
-	a := make([]string, 9)
-	a[0] = "("
-	a[1] = fmt.Sprintf("%g", v[0])
-	i := 1
-	for j := 2; j < 8; j = j + 2 {
-		switch {
-		case math.Signbit(v[i]):
-			a[j] = fmt.Sprintf("%g", v[i])
-		case math.IsInf(v[i], +1):
-			a[j] = "+Inf"
-		default:
-			a[j] = fmt.Sprintf("+%g", v[i])
-		}
-		a[j+1] = symbPerplex[i]
-		i++
+	return appendComponents(dst, v[:], symbols(symbPerplex[:], symbPerplexASCII[:]))
+}
+
+// AppendText implements the encoding.TextAppender interface. It appends the
+// string version of z to b, and never returns an error.
+func (z *Perplex) AppendText(b []byte) ([]byte, error) {
+	return z.AppendString(b), nil
+}
+
+// MarshalText implements encoding.TextMarshaler; see the package documentation.
+func (z Perplex) MarshalText() ([]byte, error) {
+	return z.AppendString(nil), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It sets z
+// equal to the value represented by text, with the syntax of ParsePerplex. If text
+// is not valid, then z is left unchanged and the error is returned.
+func (z *Perplex) UnmarshalText(text []byte) error {
+	y, err := ParsePerplex(string(text))
+	if err != nil {
+		return err
 	}
-	a[8] = ")"
-	return strings.Join(a, "")
+	z.Copy(y)
+	return nil
 }
 
-// Equals returns true if z and y are equal.
+// Format implements fmt.Formatter; see the package documentation.
+func (z *Perplex) Format(s fmt.State, verb rune) {
+	v := make([]float64, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	symb := symbols(symbPerplex[:], symbPerplexASCII[:])
+	formatComponents(s, verb, v, symb, z)
+}
+
+// Latex returns the LaTeX representation of a Perplex value.
+//
+// If z corresponds to a + bs + cε + dεs, then the string is
+// "a + bs + c\varepsilon + d\varepsilon s", with negative coefficients written with a minus
+// sign, and exponents written as powers of 10.
+func (z *Perplex) Latex() string {
+	v := make([]float64, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	return latexComponents(v, symbPerplexLatex[:])
+}
+
+// FormatWith returns the string version of a Perplex value, formatted with the
+// options o. A nil o prints the same string as String.
+func (z *Perplex) FormatWith(o *FormatOptions) string {
+	v := make([]float64, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	return o.format(v, symbPerplex[:], symbPerplexASCII[:], symbPerplexLatex[:])
+}
+
+// GoString returns the Go syntax of a Perplex value, as a call to NewPerplex such as
+// "dual.NewPerplex(1, 2, 3, 4)". It is used by the "%#v" verb.
+func (z *Perplex) GoString() string {
+	v := make([]float64, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	return goCall("dual.NewPerplex", v...)
+}
+
+// Equals returns true if z and y are equal, within the absolute tolerance
+// Tolerance.
 func (z *Perplex) Equals(y *Perplex) bool {
-	if !z.Real().Equals(y.Real()) || !z.Dual().Equals(y.Dual()) {
-		return false
-	}
-	return true
+	return z.EqualsTol(y, Tolerance)
+}
+
+// EqualsTol returns true if each component of z differs from the matching
+// component of y by at most tol.
+func (z *Perplex) EqualsTol(y *Perplex, tol float64) bool {
+	return equalsTol(z.components(), y.components(), tol)
+}
+
+// Equal returns true if each component of z has the same bits as the matching
+// component of y. Unlike Equals, it tells 0 from -0, and a NaN is equal to
+// itself.
+func (z *Perplex) Equal(y *Perplex) bool {
+	return equalBits(z.components(), y.components())
+}
+
+// Hash64 returns a map-key hash of z; see the package documentation.
+func (z *Perplex) Hash64(step float64) uint64 {
+	return hash64(z.components(), step)
+}
+
+// Cmp compares z and y in lexicographic order of their components, as given by
+// Cartesian, and returns -1, 0, or +1 as z is less than, equal to, or greater
+// than y. Each pair of components is compared as by cmp.Compare, so 0 equals -0
+// and a NaN is less than any other value and equal to another NaN. This is a
+// total order for sorting and searching, unrelated to the algebra.
+func (z *Perplex) Cmp(y *Perplex) int {
+	return compare(z.components(), y.components())
+}
+
+// Diff returns how z differs from y; see the package documentation.
+func (z *Perplex) Diff(y *Perplex) Diff {
+	return diff(z.components(), y.components(), symbols(symbPerplex[:], symbPerplexASCII[:]))
+}
+
+// EqualsULP compares z and y in ULPs; see the package documentation.
+func (z *Perplex) EqualsULP(y *Perplex, ulps uint) bool {
+	return equalsULP(z.components(), y.components(), ulps)
 }
 
 // Copy copies y onto z, and returns z.
@@ -106,11 +208,19 @@ func (z *Perplex) IsInf() bool {
 	return false
 }
 
+// PerplexInf returns a pointer to a dual perplex infinity value.
+func PerplexInf(a, b, c, d int) *Perplex {
+	z := new(Perplex)
+	z.SetReal(new(split.Complex).Inf(a, b))
+	z.SetDual(new(split.Complex).Inf(c, d))
+	return z
+}
+
 // Inf sets z equal to a dual perplex infinity value.
+//
+// Deprecated: Use PerplexInf, which matches the other types of this package.
 func (z *Perplex) Inf(a, b, c, d int) *Perplex {
-	z.Real().Inf(a, b)
-	z.Dual().Inf(c, d)
-	return z
+	return z.Copy(PerplexInf(a, b, c, d))
 }
 
 // IsNaN returns true if any component of z is NaN and neither is an
@@ -125,11 +235,33 @@ func (z *Perplex) IsNaN() bool {
 	return false
 }
 
+// PerplexNaN returns a pointer to a dual perplex NaN value.
+func PerplexNaN() *Perplex {
+	z := new(Perplex)
+	z.SetReal(new(split.Complex).NaN())
+	z.SetDual(new(split.Complex).NaN())
+	return z
+}
+
 // NaN sets z equal to a dual perplex NaN value.
+//
+// Deprecated: Use PerplexNaN, which matches the other types of this package.
 func (z *Perplex) NaN() *Perplex {
-	z.Real().NaN()
-	z.Dual().NaN()
-	return z
+	return z.Copy(PerplexNaN())
+}
+
+// IsFinite returns true if every component of z is finite, that is, neither
+// infinite nor NaN.
+func (z *Perplex) IsFinite() bool {
+	v := z.components()
+	return isFinite(v)
+}
+
+// Validate returns nil if every component of z is finite, and otherwise a
+// *NonFiniteError that reports the first component that is infinite or NaN.
+func (z *Perplex) Validate() error {
+	v := z.components()
+	return validate(v, symbols(symbPerplex[:], symbPerplexASCII[:]))
 }
 
 // Scal sets z equal to y scaled by a (with a being a split.Complex pointer),
@@ -153,16 +285,18 @@ func (z *Perplex) Dil(y *Perplex, a float64) *Perplex {
 	return z
 }
 
-// Neg sets z equal to the negative of y, and returns z.
+// Neg sets z equal to the negative of y, and returns z. It flips the sign of
+// each component, zeros included.
 func (z *Perplex) Neg(y *Perplex) *Perplex {
-	return z.Dil(y, -1)
+	a, b, c, d := y.Cartesian()
+	return z.Set(-a, -b, -c, -d)
 }
 
-// Conj sets z equal to the conjugate of y, and returns z.
+// Conj sets z equal to the conjugate of y, and returns z. It flips the sign of
+// each component but the first, zeros included.
 func (z *Perplex) Conj(y *Perplex) *Perplex {
-	z.Real().Conj(y.Real())
-	z.Dual().Neg(y.Dual())
-	return z
+	a, b, c, d := y.Cartesian()
+	return z.Set(a, -b, -c, -d)
 }
 
 // Add sets z equal to the sum of x and y, and returns z.
@@ -189,22 +323,112 @@ func (z *Perplex) Sub(x, y *Perplex) *Perplex {
 //      εs * s = s * εs = +ε
 //      ε * εs = εs * ε = 0
 // This multiplication rule is noncommutative but associative.
+//
+// The product is written into the parts of z, so that Mul does not allocate
+// unless z is the zero value.
 func (z *Perplex) Mul(x, y *Perplex) *Perplex {
-	p := new(Perplex).Copy(x)
-	q := new(Perplex).Copy(y)
-	z.Real().Mul(p.Real(), q.Real())
-	z.Dual().Mul(q.Dual(), p.Real())
-	z.Dual().Add(z.Dual(), q.Dual().Mul(p.Dual(), q.Real().Conj(q.Real())))
+	var a, s, t split.Complex
+	s.Mul(y.Dual(), x.Real())
+	t.Mul(x.Dual(), t.Conj(y.Real()))
+	a.Mul(x.Real(), y.Real())
+	s.Add(&s, &t)
+	return z.setParts(&a, &s)
+}
+
+// setParts sets the real and dual parts of z equal to a and b, and returns z.
+// The values are copied into the parts of z, and only nil parts are allocated.
+func (z *Perplex) setParts(a, b *split.Complex) *Perplex {
+	if z[0] == nil {
+		z[0] = new(split.Complex)
+	}
+	if z[1] == nil {
+		z[1] = new(split.Complex)
+	}
+	z[0].Copy(a)
+	z[1].Copy(b)
 	return z
 }
 
+// MulAdd sets z equal to x*y + w, and returns z. Each part is computed with
+// fused multiply-adds.
+func (z *Perplex) MulAdd(x, y, w *Perplex) *Perplex {
+	var c split.Complex
+	c.Conj(y.Real())
+	g := addMulSplitFMA(addMulSplitFMA(*w.Dual(), y.Dual(), x.Real()), x.Dual(), &c)
+	if math.IsNaN(g[0]) || math.IsNaN(g[1]) {
+		var s, t split.Complex
+		s.Mul(y.Dual(), x.Real())
+		t.Mul(x.Dual(), &c)
+		g.Add(s.Add(&s, &t), w.Dual())
+	}
+	a := addMulSplitFMA(*w.Real(), x.Real(), y.Real())
+	return z.setParts(&a, &g)
+}
+
+// addMulSplitFMA returns w + xy for the split-complex numbers w, x, and y,
+// with each part computed with fused multiply-adds.
+func addMulSplitFMA(w split.Complex, x, y *split.Complex) split.Complex {
+	a, b := x.Cartesian()
+	c, d := y.Cartesian()
+	return split.Complex{
+		math.FMA(a, c, math.FMA(b, d, w[0])),
+		math.FMA(a, d, math.FMA(b, c, w[1])),
+	}
+}
+
 // Commutator sets z equal to the commutator of x and y, and returns z.
 func (z *Perplex) Commutator(x, y *Perplex) *Perplex {
-	z.Mul(x, y)
-	return z.Sub(z, new(Perplex).Mul(y, x))
+	var p, q Perplex
+	p.Sub(p.Mul(x, y), q.Mul(y, x))
+	z.SetReal(p.Real())
+	z.SetDual(p.Dual())
+	return z
 }
 
 // Quad returns the quadrance of z, a float64 value.
 func (z *Perplex) Quad() float64 {
 	return z.Real().Quad()
 }
+
+// DualConj sets z equal to the dual conjugate of y, which negates the dual
+// part, and returns z:
+// 		DualConj(a + bs + cε + dεs) = a + bs - cε - dεs
+func (z *Perplex) DualConj(y *Perplex) *Perplex {
+	z.SetReal(new(split.Complex).Copy(y.Real()))
+	z.SetDual(new(split.Complex).Neg(y.Dual()))
+	return z
+}
+
+// DualQuad returns the dual quadrance of z, a pointer to a Real value. It is
+// the quadrance computed with the dual numbers a + cε and b + dε as
+// coefficients:
+// 		DualQuad(a + bs + cε + dεs) = (a² - b²) + 2(ac - bd)ε
+func (z *Perplex) DualQuad() *Real {
+	a, b, c, d := z.Cartesian()
+	return NewReal((a*a)-(b*b), 2*((a*c)-(b*d)))
+}
+
+// Idempotent returns the components of z in the idempotent basis
+// e₊ = (1 + s)/2 and e₋ = (1 - s)/2, the light-cone coordinates of z with
+// dual coefficients:
+// 		a + bs + cε + dεs = ((a + b) + (c + d)ε)e₊ + ((a - b) + (c - d)ε)e₋
+// Since e₊e₋ = 0 and e₊² = e₊, e₋² = e₋, a product with dual coefficients is
+// componentwise in this basis; for example, DualQuad(z) = pm. Mul is not, as
+// its dual part conjugates.
+func (z *Perplex) Idempotent() (p, m *Real) {
+	a, b, c, d := z.Cartesian()
+	return NewReal(a+b, c+d), NewReal(a-b, c-d)
+}
+
+// PerplexFromIdempotent returns a pointer to the Perplex value with the
+// components p and m in the idempotent basis. This is the inverse of
+// Idempotent:
+// 		PerplexFromIdempotent(p, m) = pe₊ + me₋
+func PerplexFromIdempotent(p, m *Real) *Perplex {
+	return NewPerplex(
+		(p.Real()+m.Real())/2,
+		(p.Real()-m.Real())/2,
+		(p.Dual()+m.Dual())/2,
+		(p.Dual()-m.Dual())/2,
+	)
+}
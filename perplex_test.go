@@ -0,0 +1,33 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "testing"
+
+func TestPerplexInfNaN(t *testing.T) {
+	if z := PerplexInf(+1, 0, 0, -1); !z.IsInf() || z.IsNaN() {
+		t.Errorf("PerplexInf(+1, 0, 0, -1) = %v", z)
+	}
+	if z := PerplexNaN(); !z.IsNaN() || z.IsInf() {
+		t.Errorf("PerplexNaN() = %v", z)
+	}
+	var z Perplex
+	if !z.NaN().IsNaN() {
+		t.Errorf("zero Perplex NaN() = %v", &z)
+	}
+}
+
+func TestPerplexIdempotent(t *testing.T) {
+	z := NewPerplex(3, 1, 2, 5)
+	p, m := z.Idempotent()
+	if !p.Equals(NewReal(4, 7)) || !m.Equals(NewReal(2, -3)) {
+		t.Errorf("%v.Idempotent() = %v, %v, want (4+7ε), (2-3ε)", z, p, m)
+	}
+	if got := new(Real).Mul(p, m); !got.Equals(z.DualQuad()) {
+		t.Errorf("pm = %v, want DualQuad %v", got, z.DualQuad())
+	}
+	if got := PerplexFromIdempotent(p, m); !got.Equals(z) {
+		t.Errorf("PerplexFromIdempotent(%v, %v) = %v, want %v", p, m, got, z)
+	}
+}
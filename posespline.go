@@ -0,0 +1,85 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+// A PoseSpline is a uniform cubic B-spline of rigid motions in cumulative form,
+// for smooth camera paths and robot trajectories. The control poses are unit
+// dual quaternions, as in Integrate, with pose k placed at the time k·Step. On
+// the segment from the time (i+1)·Step to (i+2)·Step, with u the fraction of
+// the segment that has passed, the pose is
+// 		T(u) = Tᵢ exp(B₁(u)Ω₁) exp(B₂(u)Ω₂) exp(B₃(u)Ω₃)
+// with Ωⱼ = log(Tᵢ₊ⱼ₋₁⁻¹ Tᵢ₊ⱼ) the relative motion between neighbouring poses
+// and Bⱼ the cumulative cubic B-spline basis:
+// 		B₁(u) = (5 + 3u - 3u² + u³)/6
+// 		B₂(u) = (1 + 3u + 3u² - 2u³)/6
+// 		B₃(u) = u³/6
+// The spline is twice continuously differentiable. It does not pass through
+// the control poses in general, but poses sampled from a motion with a
+// constant twist give back that motion exactly.
+type PoseSpline struct {
+	Poses []Hamilton
+	Step  float64
+}
+
+// Domain returns the times at which s starts and ends, Step and (n-2)·Step for
+// n control poses.
+func (s *PoseSpline) Domain() (start, end float64) {
+	return s.Step, float64(len(s.Poses)-2) * s.Step
+}
+
+// Eval returns the pose of s at the time t, a pointer to a unit dual quaternion.
+// If x is not nil, it is set to the twist of s at t, in the fixed frame, as in
+// Integrate. A time outside the Domain extrapolates the first or last segment.
+// If s has fewer than four control poses, then Eval panics.
+func (s *PoseSpline) Eval(t float64, x *Twist) *Hamilton {
+	if len(s.Poses) < 4 {
+		panic("too few control poses")
+	}
+	i := int(t/s.Step) - 1
+	i = max(0, min(i, len(s.Poses)-4))
+	u := (t / s.Step) - float64(i+1)
+	b := [3]float64{
+		(5 + (3 * u) - (3 * u * u) + (u * u * u)) / 6,
+		(1 + (3 * u) + (3 * u * u) - (2 * u * u * u)) / 6,
+		(u * u * u) / 6,
+	}
+	db := [3]float64{
+		(3 - (6 * u) + (3 * u * u)) / 6,
+		(3 + (6 * u) - (6 * u * u)) / 6,
+		(3 * u * u) / 6,
+	}
+	var a, ω [3]Hamilton
+	for j := range a {
+		inv := inverseMotion(&s.Poses[i+j])
+		rel := compose(&inv, &s.Poses[i+j+1])
+		w, v := logMotion(&rel)
+		ω[j] = pure(w, v)
+		a[j] = expTwist(scale3(w, b[j]), scale3(v, b[j]))
+	}
+	q := s.Poses[i]
+	for j := range a {
+		q = compose(&q, &a[j])
+	}
+	if x != nil {
+		// dT/du = Tᵢ Σⱼ A₁⋯(Bⱼ'ΩⱼAⱼ)⋯A₃, and the twist is 2(dT/dt)T*.
+		var dq Hamilton
+		for j := range a {
+			p := s.Poses[i]
+			for k := range a {
+				if k == j {
+					w := ω[j]
+					w.Dil(&w, db[j])
+					p = compose(&p, &w)
+				}
+				p = compose(&p, &a[k])
+			}
+			dq.Add(&dq, &p)
+		}
+		dq.Dil(&dq, 2/s.Step)
+		inv := inverseMotion(&q)
+		e := compose(&dq, &inv)
+		x.set(e.VectorPart())
+	}
+	return &q
+}
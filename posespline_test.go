@@ -0,0 +1,63 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPoseSpline(t *testing.T) {
+	const tol = 1e-9
+	// Poses sampled from a screw motion with a constant twist.
+	x := NewTwist([3]float64{0.3, -0.2, 0.9}, [3]float64{1, 0.5, -0.4})
+	q0 := motion([3]float64{1, 0, 0}, 0.4, [3]float64{1, 2, 3})
+	s := &PoseSpline{Step: 0.5}
+	for k := 0; k < 7; k++ {
+		s.Poses = append(s.Poses, *new(Hamilton).Integrate(q0, x, float64(k)*s.Step, NormalizeNone))
+	}
+	start, end := s.Domain()
+	if start != 0.5 || end != 2.5 {
+		t.Errorf("Domain = %v, %v, want 0.5, 2.5", start, end)
+	}
+	for tt := start; tt <= end; tt += 0.15 {
+		var got Twist
+		q := s.Eval(tt, &got)
+		if want := new(Hamilton).Integrate(q0, x, tt, NormalizeNone); !q.EqualsTol(want, tol) {
+			t.Errorf("Eval(%v) = %v, want %v", tt, q, want)
+		}
+		if !(*DualVec3)(&got).Equals((*DualVec3)(x)) {
+			t.Errorf("twist at %v = %v, want %v", tt, &got, x)
+		}
+	}
+}
+
+func TestPoseSplineTwist(t *testing.T) {
+	// An irregular path: the twist must match the derivative of the pose.
+	s := &PoseSpline{Step: 1, Poses: []Hamilton{
+		*motion([3]float64{0, 0, 1}, 0, [3]float64{0, 0, 0}),
+		*motion([3]float64{0, 0, 1}, 0.5, [3]float64{1, 0, 0}),
+		*motion([3]float64{0, 1, 0}, 0.2, [3]float64{2, 1, 0}),
+		*motion([3]float64{1, 0, 0}, -0.4, [3]float64{2, 3, 1}),
+		*motion([3]float64{0, 0.6, 0.8}, 1, [3]float64{0, 3, 2}),
+	}}
+	for _, tt := range []float64{1, 1.3, 2.2, 2.9} {
+		var x Twist
+		q := s.Eval(tt, &x)
+		if math.Abs(q.Quad()-1) > 1e-12 {
+			t.Errorf("pose at %v has quadrance %v", tt, q.Quad())
+		}
+		const h = 1e-6
+		p := s.Eval(tt+h, nil)
+		if want := new(Hamilton).Integrate(q, &x, h, NormalizeNone); !p.EqualsTol(want, 1e-9) {
+			t.Errorf("pose after %v = %v, want %v", tt, p, want)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Eval with three poses did not panic")
+		}
+	}()
+	(&PoseSpline{Step: 1, Poses: s.Poses[:3]}).Eval(1, nil)
+}
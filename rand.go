@@ -0,0 +1,190 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"math/rand"
+)
+
+// The Rand constructors return values whose components are independent and
+// uniformly distributed in the half-open interval [-scale, scale), drawn from
+// r. The same source and scale give the same sequence of values, so a test or
+// a simulation can be repeated by seeding r.
+
+// uniform returns a value drawn uniformly from [-scale, scale).
+func uniform(r *rand.Rand, scale float64) float64 {
+	return scale * (2*r.Float64() - 1)
+}
+
+// RandReal returns a pointer to a random Real value.
+func RandReal(r *rand.Rand, scale float64) *Real {
+	z := new(Real)
+	for i := range z {
+		z[i] = uniform(r, scale)
+	}
+	return z
+}
+
+// RandComplex returns a pointer to a random Complex value.
+func RandComplex(r *rand.Rand, scale float64) *Complex {
+	var v [4]float64
+	for i := range v {
+		v[i] = uniform(r, scale)
+	}
+	return NewComplex(v[0], v[1], v[2], v[3])
+}
+
+// RandHamilton returns a pointer to a random Hamilton value.
+func RandHamilton(r *rand.Rand, scale float64) *Hamilton {
+	var v [8]float64
+	for i := range v {
+		v[i] = uniform(r, scale)
+	}
+	return NewHamilton(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+}
+
+// RandPerplex returns a pointer to a random Perplex value.
+func RandPerplex(r *rand.Rand, scale float64) *Perplex {
+	var v [4]float64
+	for i := range v {
+		v[i] = uniform(r, scale)
+	}
+	return NewPerplex(v[0], v[1], v[2], v[3])
+}
+
+// RandSuper returns a pointer to a random Super value.
+func RandSuper(r *rand.Rand, scale float64) *Super {
+	z := new(Super)
+	for i := range z {
+		z[i] = uniform(r, scale)
+	}
+	return z
+}
+
+// RandHyper returns a pointer to a random Hyper value.
+func RandHyper(r *rand.Rand, scale float64) *Hyper {
+	z := new(Hyper)
+	for i := range z {
+		z[i] = uniform(r, scale)
+	}
+	return z
+}
+
+// RandUltra returns a pointer to a random Ultra value.
+func RandUltra(r *rand.Rand, scale float64) *Ultra {
+	z := new(Ultra)
+	for i := range z {
+		z[i] = uniform(r, scale)
+	}
+	return z
+}
+
+// A Dist draws a random float64 value from r. The Sample constructors draw each
+// component of a value from a Dist.
+type Dist func(r *rand.Rand) float64
+
+// Normal returns a Dist for the normal distribution with mean mu and standard
+// deviation sigma.
+func Normal(mu, sigma float64) Dist {
+	return func(r *rand.Rand) float64 {
+		return mu + sigma*r.NormFloat64()
+	}
+}
+
+// Uniform returns a Dist for the uniform distribution on the half-open
+// interval [a, b).
+func Uniform(a, b float64) Dist {
+	return func(r *rand.Rand) float64 {
+		return a + (b-a)*r.Float64()
+	}
+}
+
+// sample sets the elements of v to values drawn from the matching elements of
+// d, in order. If d is shorter than v, its last element is used for the rest of
+// v, so a single Dist applies to every component. If d is empty, then sample
+// panics.
+func sample(r *rand.Rand, v []float64, d []Dist) {
+	if len(d) == 0 {
+		panic("no distribution")
+	}
+	for i := range v {
+		v[i] = d[min(i, len(d)-1)](r)
+	}
+}
+
+// SampleReal returns a pointer to a Real value with components drawn from d, in
+// the order of Cartesian.
+func SampleReal(r *rand.Rand, d ...Dist) *Real {
+	z := new(Real)
+	sample(r, z[:], d)
+	return z
+}
+
+// SampleComplex returns a pointer to a Complex value with components drawn from
+// d, in the order of Cartesian.
+func SampleComplex(r *rand.Rand, d ...Dist) *Complex {
+	var v [4]float64
+	sample(r, v[:], d)
+	return NewComplex(v[0], v[1], v[2], v[3])
+}
+
+// SampleHamilton returns a pointer to a Hamilton value with components drawn
+// from d, in the order of Cartesian.
+func SampleHamilton(r *rand.Rand, d ...Dist) *Hamilton {
+	var v [8]float64
+	sample(r, v[:], d)
+	return NewHamilton(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+}
+
+// SamplePerplex returns a pointer to a Perplex value with components drawn from
+// d, in the order of Cartesian.
+func SamplePerplex(r *rand.Rand, d ...Dist) *Perplex {
+	var v [4]float64
+	sample(r, v[:], d)
+	return NewPerplex(v[0], v[1], v[2], v[3])
+}
+
+// SampleSuper returns a pointer to a Super value with components drawn from d.
+func SampleSuper(r *rand.Rand, d ...Dist) *Super {
+	z := new(Super)
+	sample(r, z[:], d)
+	return z
+}
+
+// SampleHyper returns a pointer to a Hyper value with components drawn from d.
+func SampleHyper(r *rand.Rand, d ...Dist) *Hyper {
+	z := new(Hyper)
+	sample(r, z[:], d)
+	return z
+}
+
+// SampleUltra returns a pointer to a Ultra value with components drawn from d.
+func SampleUltra(r *rand.Rand, d ...Dist) *Ultra {
+	z := new(Ultra)
+	sample(r, z[:], d)
+	return z
+}
+
+// SampleUnitHamilton returns a pointer to a Hamilton value whose real part is
+// uniformly distributed on the unit sphere of quaternions, and whose dual part
+// has components drawn from d. If d is empty, the dual part is zero.
+func SampleUnitHamilton(r *rand.Rand, d ...Dist) *Hamilton {
+	var v [8]float64
+	for {
+		for i := 0; i < 4; i++ {
+			v[i] = r.NormFloat64()
+		}
+		if h := math.Sqrt(v[0]*v[0] + v[1]*v[1] + v[2]*v[2] + v[3]*v[3]); h > 1e-8 {
+			for i := 0; i < 4; i++ {
+				v[i] /= h
+			}
+			break
+		}
+	}
+	if len(d) > 0 {
+		sample(r, v[4:], d)
+	}
+	return NewHamilton(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+}
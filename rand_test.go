@@ -0,0 +1,90 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestRand(t *testing.T) {
+	const scale = 3
+	r := rand.New(rand.NewSource(1))
+	var v []float64
+	for i := 0; i < 100; i++ {
+		a, b := RandReal(r, scale).Cartesian()
+		c, d, e, f := RandComplex(r, scale).Cartesian()
+		g, h, j, k := RandPerplex(r, scale).Cartesian()
+		v = append(v, a, b, c, d, e, f, g, h, j, k)
+		p, q, u, w, x, y, m, n := RandHamilton(r, scale).Cartesian()
+		v = append(v, p, q, u, w, x, y, m, n)
+		v = append(v, RandSuper(r, scale)[:]...)
+		v = append(v, RandHyper(r, scale)[:]...)
+		v = append(v, RandUltra(r, scale)[:]...)
+	}
+	neg := false
+	for _, a := range v {
+		if a < -scale || a >= scale || math.IsNaN(a) {
+			t.Fatalf("component %v is outside [-%v, %v)", a, scale, scale)
+		}
+		neg = neg || a < 0
+	}
+	if !neg {
+		t.Errorf("no negative components")
+	}
+	x := RandHamilton(rand.New(rand.NewSource(7)), 1)
+	y := RandHamilton(rand.New(rand.NewSource(7)), 1)
+	if !x.Equals(y) {
+		t.Errorf("same seed gave %v and %v", x, y)
+	}
+}
+
+func TestSample(t *testing.T) {
+	const n = 20000
+	r := rand.New(rand.NewSource(1))
+	var m, s [2]float64
+	for i := 0; i < n; i++ {
+		z := SampleReal(r, Normal(3, 2), Normal(-1, 0.5))
+		for j := range z {
+			m[j] += z[j] / n
+			s[j] += z[j] * z[j] / n
+		}
+	}
+	for j, want := range [2][2]float64{{3, 2}, {-1, 0.5}} {
+		sd := math.Sqrt(s[j] - m[j]*m[j])
+		if math.Abs(m[j]-want[0]) > 0.05 || math.Abs(sd-want[1]) > 0.05 {
+			t.Errorf("component %d has mean %v and deviation %v, want %v", j, m[j], sd, want)
+		}
+	}
+	for i := 0; i < 100; i++ {
+		z := SampleUltra(r, Uniform(-1, 1), Uniform(10, 11))
+		for j, a := range z {
+			lo, hi := 10.0, 11.0
+			if j == 0 {
+				lo, hi = -1, 1
+			}
+			if a < lo || a >= hi {
+				t.Fatalf("component %d = %v is outside its box", j, a)
+			}
+		}
+		h := SampleUnitHamilton(r, Normal(0, 1))
+		a, b, c, d, e, _, _, _ := h.Cartesian()
+		if q := a*a + b*b + c*c + d*d; math.Abs(q-1) > 1e-12 {
+			t.Errorf("real part of %v has quadrance %v", h, q)
+		}
+		if e == 0 {
+			t.Errorf("dual part of %v was not sampled", h)
+		}
+	}
+	if _, _, _, _, e, f, g, h := SampleUnitHamilton(r).Cartesian(); e != 0 || f != 0 || g != 0 || h != 0 {
+		t.Errorf("SampleUnitHamilton without a Dist has a nonzero dual part")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("SampleComplex without a Dist did not panic")
+		}
+	}()
+	SampleComplex(r)
+}
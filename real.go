@@ -6,12 +6,22 @@ package dual
 import (
 	"fmt"
 	"math"
-	"strings"
 )
 
 // A Real represents a dual real number.
 type Real [2]float64
 
+var (
+	// Symbols for the canonical dual real basis.
+	symbReal = [2]string{"", "ε"}
+
+	// ASCII symbols for the canonical dual real basis.
+	symbRealASCII = [2]string{"", "eps"}
+
+	// LaTeX symbols for the canonical dual real basis.
+	symbRealLatex = [2]string{"", `\varepsilon`}
+)
+
 // Real returns the real part of z, a float64 value.
 func (z *Real) Real() float64 {
 	return z[0]
@@ -39,32 +49,144 @@ func (z *Real) Cartesian() (a, b float64) {
 	return
 }
 
+// Set sets the two Cartesian components of z, in the order returned by
+// Cartesian, and returns z.
+func (z *Real) Set(a, b float64) *Real {
+	z.SetReal(a)
+	z.SetDual(b)
+	return z
+}
+
+// Polar returns the modulus r and the dual argument t of z, such that
+// z = r(1 + tε). The modulus is the real part of z, and it can be negative. If
+// z is a zero divisor, then t is infinite or NaN.
+func (z *Real) Polar() (r, t float64) {
+	r = z.Real()
+	t = z.Dual() / r
+	return
+}
+
+// RealFromPolar returns a pointer to a Real value made from a given modulus r
+// and dual argument t. This is the inverse of Polar:
+// 		RealFromPolar(r, t) = r(1 + tε)
+func RealFromPolar(r, t float64) *Real {
+	return NewReal(r, r*t)
+}
+
 // String returns the string version of a Real value.
 //
 // If z = a + bε, then the string is "(a+bε)", similar to complex128 values.
 func (z *Real) String() string {
-	a := make([]string, 5)
-	a[0] = "("
-	a[1] = fmt.Sprintf("%g", z.Real())
-	switch {
-	case math.Signbit(z.Dual()):
-		a[2] = fmt.Sprintf("%g", z.Dual())
-	case math.IsInf(z.Dual(), +1):
-		a[2] = "+Inf"
-	default:
-		a[2] = fmt.Sprintf("+%g", z.Dual())
+	return string(z.AppendString(nil))
+}
+
+// AppendString appends the string version of z, as returned by String, to dst
+// and returns the extended buffer.
+func (z *Real) AppendString(dst []byte) []byte {
+	return appendComponents(dst, z[:], symbols(symbReal[:], symbRealASCII[:]))
+}
+
+// AppendText implements the encoding.TextAppender interface. It appends the
+// string version of z to b, and never returns an error.
+func (z *Real) AppendText(b []byte) ([]byte, error) {
+	return z.AppendString(b), nil
+}
+
+// MarshalText implements encoding.TextMarshaler; see the package documentation.
+func (z Real) MarshalText() ([]byte, error) {
+	return z.AppendString(nil), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It sets z
+// equal to the value represented by text, with the syntax of ParseReal. If text
+// is not valid, then z is left unchanged and the error is returned.
+func (z *Real) UnmarshalText(text []byte) error {
+	y, err := ParseReal(string(text))
+	if err != nil {
+		return err
 	}
-	a[3] = "ε"
-	a[4] = ")"
-	return strings.Join(a, "")
+	z.Copy(y)
+	return nil
 }
 
-// Equals returns true if z and y are equal.
-func (z *Real) Equals(y *Real) bool {
-	if notEquals(z.Real(), y.Real()) || notEquals(z.Dual(), y.Dual()) {
-		return false
+// Format implements fmt.Formatter; see the package documentation.
+func (z *Real) Format(s fmt.State, verb rune) {
+	v := []float64{z.Real(), z.Dual()}
+	symb := symbols(symbReal[:], symbRealASCII[:])
+	formatComponents(s, verb, v, symb, z)
+}
+
+// Latex returns the LaTeX representation of a Real value.
+//
+// If z corresponds to a + bε, then the string is
+// "a + b\varepsilon", with negative coefficients written with a minus
+// sign, and exponents written as powers of 10.
+func (z *Real) Latex() string {
+	v := []float64{z.Real(), z.Dual()}
+	return latexComponents(v, symbRealLatex[:])
+}
+
+// FormatWith returns the string version of a Real value, formatted with the
+// options o. A nil o prints the same string as String. With the Exponential
+// option, z is printed as r·exp(tε), with r and t given by Polar.
+func (z *Real) FormatWith(o *FormatOptions) string {
+	if o != nil && o.Exponential && !z.IsZeroDiv() {
+		symb := o.basis(symbReal[:], symbRealASCII[:], symbRealLatex[:])
+		r, t := z.Polar()
+		return o.exponential(r, t, symb[1])
 	}
-	return true
+	v := []float64{z.Real(), z.Dual()}
+	return o.format(v, symbReal[:], symbRealASCII[:], symbRealLatex[:])
+}
+
+// GoString returns the Go syntax of a Real value, as a call to NewReal such as
+// "dual.NewReal(1, 2)". It is used by the "%#v" verb.
+func (z *Real) GoString() string {
+	v := []float64{z.Real(), z.Dual()}
+	return goCall("dual.NewReal", v...)
+}
+
+// Equals returns true if z and y are equal, within the absolute tolerance
+// Tolerance.
+func (z *Real) Equals(y *Real) bool {
+	return z.EqualsTol(y, Tolerance)
+}
+
+// EqualsTol returns true if each component of z differs from the matching
+// component of y by at most tol.
+func (z *Real) EqualsTol(y *Real, tol float64) bool {
+	return equalsTol(z[:], y[:], tol)
+}
+
+// Equal returns true if each component of z has the same bits as the matching
+// component of y. Unlike Equals, it tells 0 from -0, and a NaN is equal to
+// itself.
+func (z *Real) Equal(y *Real) bool {
+	return equalBits(z[:], y[:])
+}
+
+// Hash64 returns a map-key hash of z; see the package documentation.
+func (z *Real) Hash64(step float64) uint64 {
+	return hash64(z[:], step)
+}
+
+// Cmp compares z and y by their real parts, and then by their dual parts, and
+// returns -1, 0, or +1 as z is less than, equal to, or greater than y. Each pair
+// of parts is compared as by cmp.Compare, so 0 equals -0 and a NaN is less than
+// any other value and equal to another NaN. This is a total order for sorting
+// and searching, unrelated to the algebra.
+func (z *Real) Cmp(y *Real) int {
+	return compare(z[:], y[:])
+}
+
+// Diff returns how z differs from y; see the package documentation.
+func (z *Real) Diff(y *Real) Diff {
+	return diff(z[:], y[:], symbols(symbReal[:], symbRealASCII[:]))
+}
+
+// EqualsULP compares z and y in ULPs; see the package documentation.
+func (z *Real) EqualsULP(y *Real, ulps uint) bool {
+	return equalsULP(z[:], y[:], ulps)
 }
 
 // Copy copies y onto z, and returns z.
@@ -119,6 +241,20 @@ func RealNaN() *Real {
 	return z
 }
 
+// IsFinite returns true if every component of z is finite, that is, neither
+// infinite nor NaN.
+func (z *Real) IsFinite() bool {
+	v := z[:]
+	return isFinite(v)
+}
+
+// Validate returns nil if every component of z is finite, and otherwise a
+// *NonFiniteError that reports the first component that is infinite or NaN.
+func (z *Real) Validate() error {
+	v := z[:]
+	return validate(v, symbols(symbReal[:], symbRealASCII[:]))
+}
+
 // Scal sets z equal to y scaled by a, and returns z.
 func (z *Real) Scal(y *Real, a float64) *Real {
 	z.SetReal(y.Real() * a)
@@ -126,15 +262,19 @@ func (z *Real) Scal(y *Real, a float64) *Real {
 	return z
 }
 
-// Neg sets z equal to the negative of y, and returns z.
+// Neg sets z equal to the negative of y, and returns z. It flips the sign of
+// each component, zeros included, so that Neg(Neg(y)) has the same bits as y.
 func (z *Real) Neg(y *Real) *Real {
-	return z.Scal(y, -1)
+	z.SetReal(-y.Real())
+	z.SetDual(-y.Dual())
+	return z
 }
 
-// Conj sets z equal to the conjugate of y, and returns z.
+// Conj sets z equal to the conjugate of y, and returns z. It flips the sign of
+// the dual part, so that the conjugate of a +0 dual part is -0.
 func (z *Real) Conj(y *Real) *Real {
 	z.SetReal(y.Real())
-	z.SetDual(y.Dual() * -1)
+	z.SetDual(-y.Dual())
 	return z
 }
 
@@ -156,15 +296,54 @@ func (z *Real) Sub(x, y *Real) *Real {
 //
 // The basic rule is:
 // 		ε * ε = 0
-// This multiplication operation is commutative and associative.
+// This multiplication operation is commutative and associative. The dual part
+// is computed with a fused multiply-add, which avoids the loss of accuracy
+// when its two terms nearly cancel.
 func (z *Real) Mul(x, y *Real) *Real {
-	p := new(Real).Copy(x)
-	q := new(Real).Copy(y)
-	z.SetReal(p.Real() * q.Real())
-	z.SetDual((p.Real() * q.Dual()) + (p.Dual() * q.Real()))
+	a, b := x.Cartesian()
+	c, d := y.Cartesian()
+	z.SetReal(a * c)
+	z.SetDual(mulDualPart(a, b, c, d))
+	return z
+}
+
+// MulAdd sets z equal to x*y + w, and returns z. Each component is computed
+// with fused multiply-adds, so the real part is rounded once.
+func (z *Real) MulAdd(x, y, w *Real) *Real {
+	a, b := x.Cartesian()
+	c, d := y.Cartesian()
+	e, f := w.Cartesian()
+	g := math.FMA(a, d, math.FMA(b, c, f))
+	if math.IsNaN(g) {
+		g = crossTerm(a, d) + crossTerm(b, c) + f
+	}
+	z.SetReal(math.FMA(a, c, e))
+	z.SetDual(g)
 	return z
 }
 
+// crossTerm returns the product of a and b, or zero if one of them is zero and
+// the other is infinite. It is used for the terms of a product that make up its
+// dual part, where a zero factor means that the term is absent, so that 0·Inf
+// gives 0 instead of NaN. A NaN factor still gives NaN, as with float64
+// multiplication.
+func crossTerm(a, b float64) float64 {
+	if (a == 0 && math.IsInf(b, 0)) || (b == 0 && math.IsInf(a, 0)) {
+		return 0
+	}
+	return a * b
+}
+
+// mulDualPart returns the dual part of (a + bε)(c + dε). It is computed with a
+// fused multiply-add, and with crossTerm for each term if that gives NaN.
+func mulDualPart(a, b, c, d float64) float64 {
+	e := math.FMA(a, d, b*c)
+	if math.IsNaN(e) {
+		e = crossTerm(a, d) + crossTerm(b, c)
+	}
+	return e
+}
+
 // Quad returns the non-negative dual quadrance of z, a float64 value.
 func (z *Real) Quad() float64 {
 	return z.Real() * z.Real()
@@ -176,22 +355,88 @@ func (z *Real) IsZeroDiv() bool {
 	return !notEquals(z.Real(), 0)
 }
 
+// Cond returns a condition estimate for division by z: the ratio |z|/|a| of the
+// Euclidean norm of z = a + bε to the magnitude of its real part. It is 1 when
+// z is real, grows as z nears the zero divisors, and is +Inf for a zero real
+// part. A large Cond warns that Inv and Quo amplify errors in z by about that
+// factor.
+func (z *Real) Cond() float64 {
+	return condition(z[:], 1)
+}
+
 // Inv sets z equal to the inverse of y, and returns z. If y is a zero divisor,
-// then Inv panics.
+// then Inv panics. The inverse of an infinite real part is zero.
 func (z *Real) Inv(y *Real) *Real {
 	if y.IsZeroDiv() {
 		panic("zero divisor")
 	}
-	return z.Scal(new(Real).Conj(y), 1/y.Quad())
+	c, d := y.Cartesian()
+	r := 1 / c
+	z.SetReal(r)
+	z.SetDual(-d * r * r)
+	return z
 }
 
 // Quo sets z equal to the quotient of x and y, and returns z. If y is a zero
 // divisor, then Quo panics.
+//
+// If x = a + bε and y = c + dε, then the quotient is
+// 		a/c + ((b - (a/c)d)/c)ε
+// so that a finite x over an infinite y is zero, as with complex128 values.
 func (z *Real) Quo(x, y *Real) *Real {
 	if y.IsZeroDiv() {
 		panic("zero divisor denominator")
 	}
-	return z.Scal(new(Real).Mul(x, new(Real).Conj(y)), 1/y.Quad())
+	a, b := x.Cartesian()
+	c, d := y.Cartesian()
+	q := a / c
+	e := math.FMA(-q, d, b)
+	if math.IsNaN(e) {
+		e = b - crossTerm(q, d)
+	}
+	z.SetReal(q)
+	z.SetDual(e / c)
+	return z
+}
+
+// InvChecked sets z equal to the inverse of y, and returns z and a nil error.
+// If y is a zero divisor, then z is left unchanged and ErrZeroDivisor is
+// returned in place of the panic of Inv.
+func (z *Real) InvChecked(y *Real) (*Real, error) {
+	if y.IsZeroDiv() {
+		return z, ErrZeroDivisor
+	}
+	return z.Inv(y), nil
+}
+
+// QuoChecked sets z equal to the quotient of x and y, and returns z and a nil
+// error. If y is a zero divisor, then z is left unchanged and ErrZeroDivisor is
+// returned in place of the panic of Quo.
+func (z *Real) QuoChecked(x, y *Real) (*Real, error) {
+	if y.IsZeroDiv() {
+		return z, ErrZeroDivisor
+	}
+	return z.Quo(x, y), nil
+}
+
+// InvNaN sets z equal to the inverse of y, and returns z. If y is a zero
+// divisor, then every component of z is set to NaN in place of the panic of
+// Inv, so that the failure travels with the value, as with float64 division.
+func (z *Real) InvNaN(y *Real) *Real {
+	if y.IsZeroDiv() {
+		return z.Copy(RealNaN())
+	}
+	return z.Inv(y)
+}
+
+// QuoNaN sets z equal to the quotient of x and y, and returns z. If y is a zero
+// divisor, then every component of z is set to NaN in place of the panic of
+// Quo.
+func (z *Real) QuoNaN(x, y *Real) *Real {
+	if y.IsZeroDiv() {
+		return z.Copy(RealNaN())
+	}
+	return z.Quo(x, y)
 }
 
 // Sin sets z equal to the dual sine of y, and returns z.
@@ -231,3 +476,212 @@ func (z *Real) Cosh(y *Real) *Real {
 	z.SetDual(y.Dual() * math.Sinh(y.Real()))
 	return z
 }
+
+// Tan sets z equal to the dual tangent of y, and returns z:
+// 		tan(a + bε) = tan(a) + b sec²(a)ε
+func (z *Real) Tan(y *Real) *Real {
+	c := math.Cos(y.Real())
+	z.SetDual(y.Dual() / (c * c))
+	z.SetReal(math.Tan(y.Real()))
+	return z
+}
+
+// Tanh sets z equal to the dual hyperbolic tangent of y, and returns z:
+// 		tanh(a + bε) = tanh(a) + b(1 - tanh²(a))ε
+func (z *Real) Tanh(y *Real) *Real {
+	t := math.Tanh(y.Real())
+	z.SetDual(y.Dual() * (1 - (t * t)))
+	z.SetReal(t)
+	return z
+}
+
+// Sqrt sets z equal to the dual square root of y, and returns z:
+// 		√(a + bε) = √a + (b/(2√a))ε
+// If the real part of y is zero, then the dual part of z is infinite or NaN, as
+// the square root is not differentiable there.
+func (z *Real) Sqrt(y *Real) *Real {
+	r := math.Sqrt(y.Real())
+	z.SetDual(y.Dual() / (2 * r))
+	z.SetReal(r)
+	return z
+}
+
+// Log sets z equal to the dual natural logarithm of y, and returns z:
+// 		log(a + bε) = log(a) + (b/a)ε
+func (z *Real) Log(y *Real) *Real {
+	a, b := y.Cartesian()
+	z.SetReal(math.Log(a))
+	z.SetDual(b / a)
+	return z
+}
+
+// Log2 sets z equal to the dual binary logarithm of y, and returns z:
+// 		log₂(a + bε) = log₂(a) + (b/(a ln 2))ε
+func (z *Real) Log2(y *Real) *Real {
+	a, b := y.Cartesian()
+	z.SetReal(math.Log2(a))
+	z.SetDual(b / (a * math.Ln2))
+	return z
+}
+
+// Log10 sets z equal to the dual decimal logarithm of y, and returns z:
+// 		log₁₀(a + bε) = log₁₀(a) + (b/(a ln 10))ε
+func (z *Real) Log10(y *Real) *Real {
+	a, b := y.Cartesian()
+	z.SetReal(math.Log10(a))
+	z.SetDual(b / (a * math.Ln10))
+	return z
+}
+
+// Exp2 sets z equal to 2 raised to the power y, and returns z:
+// 		2^(a + bε) = 2ᵃ + 2ᵃ(ln 2)bε
+func (z *Real) Exp2(y *Real) *Real {
+	e := math.Exp2(y.Real())
+	z.SetDual(y.Dual() * e * math.Ln2)
+	z.SetReal(e)
+	return z
+}
+
+// Exp10 sets z equal to 10 raised to the power y, and returns z:
+// 		10^(a + bε) = 10ᵃ + 10ᵃ(ln 10)bε
+func (z *Real) Exp10(y *Real) *Real {
+	e := math.Pow(10, y.Real())
+	z.SetDual(y.Dual() * e * math.Ln10)
+	z.SetReal(e)
+	return z
+}
+
+// Cbrt sets z equal to the dual cube root of y, and returns z:
+// 		∛(a + bε) = ∛a + (b/(3∛a²))ε
+// Unlike Pow with exponent 1/3, Cbrt is defined for a negative real part. If
+// the real part of y is zero, then the dual part of z is infinite or NaN.
+func (z *Real) Cbrt(y *Real) *Real {
+	r := math.Cbrt(y.Real())
+	z.SetDual(y.Dual() / (3 * r * r))
+	z.SetReal(r)
+	return z
+}
+
+// Pow sets z equal to x raised to the power y, and returns z:
+// 		(a + bε)^(c + dε) = aᶜ + (caᶜ⁻¹b + aᶜ log(a)d)ε
+// If the dual part of y is zero, then the logarithm term is left out, so that
+// a negative a with an integer c has the dual part of an ordinary power. As in
+// Mul, a zero b drops the power term even where caᶜ⁻¹ is infinite, so that
+// Pow(0+0ε, 0.5) is 0+0ε.
+func (z *Real) Pow(x, y *Real) *Real {
+	a, b := x.Cartesian()
+	c, d := y.Cartesian()
+	p := math.Pow(a, c)
+	e := crossTerm(c*math.Pow(a, c-1), b)
+	if d != 0 {
+		e += p * math.Log(a) * d
+	}
+	z.SetReal(p)
+	z.SetDual(e)
+	return z
+}
+
+// Asin sets z equal to the dual inverse sine of y, and returns z:
+// 		asin(a + bε) = asin(a) + (b/√(1 - a²))ε
+func (z *Real) Asin(y *Real) *Real {
+	a, b := y.Cartesian()
+	z.SetReal(math.Asin(a))
+	z.SetDual(b / math.Sqrt(1-(a*a)))
+	return z
+}
+
+// Acos sets z equal to the dual inverse cosine of y, and returns z:
+// 		acos(a + bε) = acos(a) - (b/√(1 - a²))ε
+func (z *Real) Acos(y *Real) *Real {
+	a, b := y.Cartesian()
+	z.SetReal(math.Acos(a))
+	z.SetDual(-b / math.Sqrt(1-(a*a)))
+	return z
+}
+
+// Atan sets z equal to the dual inverse tangent of y, and returns z:
+// 		atan(a + bε) = atan(a) + (b/(1 + a²))ε
+func (z *Real) Atan(y *Real) *Real {
+	a, b := y.Cartesian()
+	z.SetReal(math.Atan(a))
+	z.SetDual(b / (1 + (a * a)))
+	return z
+}
+
+// Abs sets z equal to the dual absolute value of y, and returns z:
+// 		|a + bε| = |a| + sgn(a)bε
+// For a = 0, the dual part is |b|, the one-sided derivative along b.
+func (z *Real) Abs(y *Real) *Real {
+	a, b := y.Cartesian()
+	switch {
+	case a > 0:
+		z.SetDual(b)
+	case a < 0:
+		z.SetDual(-b)
+	default:
+		z.SetDual(math.Abs(b))
+	}
+	z.SetReal(math.Abs(a))
+	return z
+}
+
+// The following functions provide a value-semantics alternative to the methods
+// of Real. They do not modify any of their arguments, and return a new value. Real
+// values are comparable, so they can also be used as map keys.
+
+// RealNeg returns the negative of x.
+func RealNeg(x Real) Real {
+	var z Real
+	z.Neg(&x)
+	return z
+}
+
+// RealConj returns the conjugate of x.
+func RealConj(x Real) Real {
+	var z Real
+	z.Conj(&x)
+	return z
+}
+
+// RealScal returns x scaled by a.
+func RealScal(x Real, a float64) Real {
+	var z Real
+	z.Scal(&x, a)
+	return z
+}
+
+// RealAdd returns the sum of x and y.
+func RealAdd(x, y Real) Real {
+	var z Real
+	z.Add(&x, &y)
+	return z
+}
+
+// RealSub returns the difference of x and y.
+func RealSub(x, y Real) Real {
+	var z Real
+	z.Sub(&x, &y)
+	return z
+}
+
+// RealMul returns the product of x and y.
+func RealMul(x, y Real) Real {
+	var z Real
+	z.Mul(&x, &y)
+	return z
+}
+
+// RealInv returns the inverse of x. If x is a zero divisor, then RealInv panics.
+func RealInv(x Real) Real {
+	var z Real
+	z.Inv(&x)
+	return z
+}
+
+// RealQuo returns the quotient of x and y. If y is a zero divisor, then RealQuo
+// panics.
+func RealQuo(x, y Real) Real {
+	var z Real
+	z.Quo(&x, &y)
+	return z
+}
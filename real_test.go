@@ -316,3 +316,114 @@ func ExampleRealNaN() {
 	// Output:
 	// (NaN+NaNε)
 }
+
+func TestRealPolar(t *testing.T) {
+	var tests = []struct {
+		z    *Real
+		r, a float64
+	}{
+		{oneR, 1, 0},
+		{&Real{2, 1}, 2, 0.5},
+		{&Real{-4, 2}, -4, -0.5},
+	}
+	for _, test := range tests {
+		r, a := test.z.Polar()
+		if notEquals(r, test.r) || notEquals(a, test.a) {
+			t.Errorf("Polar(%v) = %v, %v, want %v, %v",
+				test.z, r, a, test.r, test.a)
+		}
+		if got := RealFromPolar(r, a); !got.Equals(test.z) {
+			t.Errorf("RealFromPolar(%v, %v) = %v, want %v",
+				r, a, got, test.z)
+		}
+	}
+}
+
+func TestRealFunctions(t *testing.T) {
+	// Each dual part is checked against a central difference of the real part.
+	const h = 1e-6
+	for _, test := range []struct {
+		name string
+		f    func(z, y *Real) *Real
+		a    float64
+	}{
+		{"Tan", (*Real).Tan, 0.7},
+		{"Tanh", (*Real).Tanh, -0.4},
+		{"Sqrt", (*Real).Sqrt, 2.5},
+		{"Log", (*Real).Log, 0.3},
+		{"Asin", (*Real).Asin, 0.6},
+		{"Acos", (*Real).Acos, -0.2},
+		{"Atan", (*Real).Atan, 3},
+		{"Abs", (*Real).Abs, -1.5},
+		{"Log2", (*Real).Log2, 5},
+		{"Log10", (*Real).Log10, 0.8},
+		{"Exp2", (*Real).Exp2, 1.3},
+		{"Exp10", (*Real).Exp10, -0.5},
+		{"Cbrt", (*Real).Cbrt, -8},
+	} {
+		got := test.f(new(Real), NewReal(test.a, 2))
+		p := test.f(new(Real), NewReal(test.a+h, 0)).Real()
+		q := test.f(new(Real), NewReal(test.a-h, 0)).Real()
+		if fd := 2 * (p - q) / (2 * h); math.Abs(got.Dual()-fd) > 1e-6 {
+			t.Errorf("%s(%v) has dual part %v, want %v", test.name, test.a, got.Dual(), fd)
+		}
+	}
+	x, y := NewReal(2, 1), NewReal(3, 0.5)
+	want := NewReal(8, (3*4)+(8*math.Log(2)*0.5))
+	if got := new(Real).Pow(x, y); !got.Equals(want) {
+		t.Errorf("Pow(%v, %v) = %v, want %v", x, y, got, want)
+	}
+	if got := new(Real).Pow(NewReal(-2, 1), NewReal(2, 0)); !got.Equals(NewReal(4, -4)) {
+		t.Errorf("Pow((-2+1ε), 2) = %v, want (4-4ε)", got)
+	}
+	if got := new(Real).Pow(NewReal(0, 0), NewReal(0.5, 0)); !got.Equals(NewReal(0, 0)) {
+		t.Errorf("Pow((0+0ε), 0.5) = %v, want (0+0ε)", got)
+	}
+	if got := new(Real).Pow(NewReal(0, math.NaN()), NewReal(0.5, 0)); !math.IsNaN(got.Dual()) {
+		t.Errorf("Pow((0+NaNε), 0.5) = %v, want a NaN dual part", got)
+	}
+	if got := new(Real).Abs(NewReal(0, -3)); !got.Equals(NewReal(0, 3)) {
+		t.Errorf("Abs((0-3ε)) = %v, want (0+3ε)", got)
+	}
+}
+
+func ExampleRealFromPolar() {
+	fmt.Println(RealFromPolar(2, 0.5))
+	fmt.Println(RealFromPolar(-3, 1))
+	// Output:
+	// (2+1ε)
+	// (-3-3ε)
+}
+
+func TestRealValueFunctions(t *testing.T) {
+	x, y := Real{1, 2}, Real{3, 4}
+	if got, want := RealAdd(x, y), (Real{4, 6}); got != want {
+		t.Errorf("RealAdd(%v, %v) = %v, want %v", &x, &y, &got, &want)
+	}
+	if got, want := RealMul(x, y), (Real{3, 10}); got != want {
+		t.Errorf("RealMul(%v, %v) = %v, want %v", &x, &y, &got, &want)
+	}
+	if got, want := RealQuo(RealMul(x, y), y), x; !got.Equals(&want) {
+		t.Errorf("RealQuo(%v, %v) = %v, want %v", &x, &y, &got, &want)
+	}
+	if x != (Real{1, 2}) || y != (Real{3, 4}) {
+		t.Errorf("arguments modified: %v, %v", &x, &y)
+	}
+	m := map[Real]int{RealConj(x): 1}
+	if m[Real{1, -2}] != 1 {
+		t.Errorf("Real value not usable as map key")
+	}
+}
+
+func TestRealQuoChecked(t *testing.T) {
+	z := NewReal(5, 6)
+	if _, err := z.QuoChecked(NewReal(1, 2), NewReal(0, 3)); err != ErrZeroDivisor {
+		t.Errorf("QuoChecked by zero divisor: err = %v, want %v", err, ErrZeroDivisor)
+	}
+	if !z.Equals(NewReal(5, 6)) {
+		t.Errorf("QuoChecked by zero divisor modified z: %v", z)
+	}
+	if _, err := z.InvChecked(NewReal(2, 1)); err != nil || !z.Equals(NewReal(0.5, -0.25)) {
+		t.Errorf("InvChecked((2+1ε)) = %v, %v", z, err)
+	}
+}
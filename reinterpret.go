@@ -0,0 +1,36 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "unsafe"
+
+// A Real is an array of two float64 values, so a []Real, and a DualVector,
+// is laid out in memory as contiguous float64 values with the real part of
+// each element followed by its dual part, and no padding. The functions below
+// reinterpret one slice as the other without copying. The result shares the
+// memory of the argument: writes through either are seen by both, and the
+// argument stays alive as long as the result does.
+
+// RealFloats returns x as a []float64 of length 2·len(x), in which the element
+// x[i] is at indices 2i (real part) and 2i+1 (dual part). It returns nil if x
+// has length zero.
+func RealFloats(x []Real) []float64 {
+	if len(x) == 0 {
+		return nil
+	}
+	return unsafe.Slice(&x[0][0], 2*len(x))
+}
+
+// FloatReals returns v as a []Real of length len(v)/2, with the element i made
+// from v[2i] and v[2i+1]. It is the inverse of RealFloats. If the length of v
+// is odd, then FloatReals panics. It returns nil if v has length zero.
+func FloatReals(v []float64) []Real {
+	if len(v)%2 != 0 {
+		panic("odd length")
+	}
+	if len(v) == 0 {
+		return nil
+	}
+	return unsafe.Slice((*Real)(unsafe.Pointer(&v[0])), len(v)/2)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "testing"
+
+func TestRealFloats(t *testing.T) {
+	x := DualVector{{1, 2}, {3, 4}, {5, 6}}
+	v := RealFloats(x)
+	if len(v) != 6 {
+		t.Fatalf("len = %d, want 6", len(v))
+	}
+	for i := range v {
+		if v[i] != float64(i+1) {
+			t.Errorf("RealFloats()[%d] = %v, want %v", i, v[i], i+1)
+		}
+	}
+	// The two slices share memory.
+	v[3] = -4
+	if x[1] != (Real{3, -4}) {
+		t.Errorf("x[1] = %v, want (3-4ε)", &x[1])
+	}
+	y := FloatReals(v)
+	if len(y) != 3 || &y[0] != &x[0] {
+		t.Errorf("FloatReals(RealFloats(x)) does not share the memory of x")
+	}
+	y[2].SetDual(7)
+	if v[5] != 7 {
+		t.Errorf("v[5] = %v, want 7", v[5])
+	}
+	if RealFloats(nil) != nil || FloatReals(nil) != nil {
+		t.Error("empty slices are not nil")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("FloatReals of odd length did not panic")
+		}
+	}()
+	FloatReals(make([]float64, 3))
+}
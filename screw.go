@@ -0,0 +1,111 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "math"
+
+// A Screw represents a rigid motion as a screw displacement: the rotation by
+// the angle θ about the Axis together with the translation d along it, with
+// the dual angle θ + dε in Angle. By Chasles' theorem, every rigid motion is a
+// screw displacement. As a unit dual quaternion, in the convention of
+// Integrate, the motion is
+// 		exp(½(θ + dε)(u + εm))
+// with u the direction and m the moment of the Axis.
+type Screw struct {
+	Axis  Line
+	Angle DualAngle
+}
+
+// NewScrew returns a pointer to the Screw value with axis l and dual angle θ.
+func NewScrew(l *Line, θ *DualAngle) *Screw {
+	return &Screw{Axis: *l, Angle: *θ}
+}
+
+// ScrewFromHamilton returns the screw displacement of the rigid motion encoded
+// in the unit dual quaternion q, with its angle in [0, 2π]. The identity is the
+// zero screw about the z axis, and a pure translation has its axis through the
+// origin. If q is not a unit dual quaternion, then ok is false.
+func ScrewFromHamilton(q *Hamilton) (s *Screw, ok bool) {
+	if notEquals(q.Quad(), 1) || notEquals(dotQuat(&q[0], &q[1]), 0) {
+		return nil, false
+	}
+	return unitScrew(q), true
+}
+
+// unitScrew returns the screw displacement of the unit dual quaternion q,
+// without checking that q is a unit dual quaternion.
+func unitScrew(q *Hamilton) *Screw {
+	θ, u, m, ok := q.unitScrew()
+	if !ok {
+		// A unit dual quaternion with no screw axis is the identity.
+		return NewScrew(NewLinePlucker([3]float64{0, 0, 1}, m), new(DualAngle))
+	}
+	return NewScrew(NewLinePlucker(u, m), θ)
+}
+
+// Hamilton returns the unit dual quaternion of the rigid motion z, a pointer to
+// a Hamilton value.
+func (z *Screw) Hamilton() *Hamilton {
+	u, m := z.Axis.Direction(), z.Axis.Moment()
+	θ, d := z.Angle.Angle(), z.Angle.Distance()
+	q := expTwist(scale3(u, θ/2), scale3(add3(scale3(m, θ), scale3(u, d)), 0.5))
+	return &q
+}
+
+// Pitch returns the pitch d/θ of z, the translation per unit of rotation. A
+// pure translation has an infinite pitch.
+func (z *Screw) Pitch() float64 {
+	if z.Angle[0] == 0 {
+		return math.Inf(1)
+	}
+	return z.Angle[1] / z.Angle[0]
+}
+
+// Twist returns the constant twist that carries the identity to z in unit
+// time, (θ + dε)(u + εm).
+func (z *Screw) Twist() *Twist {
+	u, m := z.Axis.Direction(), z.Axis.Moment()
+	θ, d := z.Angle.Angle(), z.Angle.Distance()
+	return NewTwist(scale3(u, θ), add3(scale3(m, θ), scale3(u, d)))
+}
+
+// String returns the string version of a Screw value.
+//
+// If z has the axis l and the dual angle θ + dε, then the string is
+// "{l (θ+dε)}", with l formatted as a Line.
+func (z *Screw) String() string {
+	return "{" + z.Axis.String() + " " + z.Angle.String() + "}"
+}
+
+// Equals returns true if z and y are equal.
+func (z *Screw) Equals(y *Screw) bool {
+	return z.Axis.Equals(&y.Axis) && z.Angle.Equals(&y.Angle)
+}
+
+// Inv sets z equal to the inverse motion of y, the screw about the same axis by
+// the negative dual angle, and returns z.
+func (z *Screw) Inv(y *Screw) *Screw {
+	z.Axis.Copy(&y.Axis)
+	z.Angle.Neg(&y.Angle)
+	return z
+}
+
+// Scal sets z equal to y with its dual angle scaled by a, and returns z. For a
+// in [0, 1], this moves along the screw from the identity to y, as in screw
+// linear interpolation.
+func (z *Screw) Scal(y *Screw, a float64) *Screw {
+	z.Axis.Copy(&y.Axis)
+	z.Angle = DualAngle{a * y.Angle[0], a * y.Angle[1]}
+	return z
+}
+
+// Compose sets z equal to the screw of the motion y followed by the motion x,
+// and returns z. The product of the two motions is normalized, so that its
+// rounding errors do not keep it from being a unit dual quaternion.
+func (z *Screw) Compose(x, y *Screw) *Screw {
+	q := compose(x.Hamilton(), y.Hamilton())
+	q.normalize(NormalizeFull)
+	*z = *unitScrew(&q)
+	return z
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"testing"
+)
+
+func TestScrew(t *testing.T) {
+	const tol = 1e-12
+	// A rotation by π/2 about the z axis through (1, 0, 0), with pitch 2.
+	l := NewLine([3]float64{1, 0, 0}, [3]float64{1, 0, 1})
+	s := NewScrew(l, NewDualAngle(math.Pi/2, math.Pi))
+	q := s.Hamilton()
+	if got, want := q.Quad(), 1.0; math.Abs(got-want) > tol {
+		t.Errorf("Quad = %v, want %v", got, want)
+	}
+	// The point (1, 0, 0) on the axis moves along it by π.
+	p := add3(rotate3(q, [3]float64{1, 0, 0}), translation3(q))
+	if want := [3]float64{1, 0, math.Pi}; !equalsTol(p[:], want[:], tol) {
+		t.Errorf("moved axis point = %v, want %v", p, want)
+	}
+	if got := s.Pitch(); math.Abs(got-2) > tol {
+		t.Errorf("Pitch = %v, want 2", got)
+	}
+	got, ok := ScrewFromHamilton(q)
+	if !ok || !got.Equals(s) {
+		t.Errorf("ScrewFromHamilton = %v, %v, want %v", got, ok, s)
+	}
+	// The twist of a screw integrates to its motion in unit time.
+	if r := new(Hamilton).Integrate(NewHamilton(1, 0, 0, 0, 0, 0, 0, 0), s.Twist(), 1, NormalizeNone); !r.EqualsTol(q, tol) {
+		t.Errorf("integrated twist = %v, want %v", r, q)
+	}
+	// Composition matches the product of dual quaternions.
+	y := NewScrew(NewLine([3]float64{0, 2, 0}, [3]float64{1, 2, 0}), NewDualAngle(0.7, -0.3))
+	c := compose(s.Hamilton(), y.Hamilton())
+	if got := new(Screw).Compose(s, y).Hamilton(); !got.EqualsTol(&c, tol) && !got.EqualsTol(new(Hamilton).Neg(&c), tol) {
+		t.Errorf("Compose = %v, want %v", got, &c)
+	}
+	if got := new(Screw).Compose(s, new(Screw).Inv(s)); got.Angle[0] > tol || got.Angle[1] > tol {
+		t.Errorf("s composed with its inverse = %v, want identity", got)
+	}
+	h := new(Screw).Scal(s, 0.5)
+	if got := new(Screw).Compose(h, h); !got.Equals(s) {
+		t.Errorf("half screw twice = %v, want %v", got, s)
+	}
+	// Large translations put rounding errors above the tolerance of
+	// ScrewFromHamilton, but Compose must still succeed.
+	a := NewScrew(NewLine([3]float64{0, 0, 0}, [3]float64{0, 0, 1}), NewDualAngle(0.3, 1e9))
+	b := NewScrew(NewLine([3]float64{5, 0, 0}, [3]float64{5, 1, 0}), NewDualAngle(1.1, -2e9))
+	c = compose(a.Hamilton(), b.Hamilton())
+	ab := new(Screw).Compose(a, b).Hamilton()
+	if d := norm3(sub3(translation3(ab), translation3(&c))); d > 1e-6*norm3(translation3(&c)) {
+		t.Errorf("Compose with large translations moves the origin to %v, want %v", translation3(ab), translation3(&c))
+	}
+	if _, ok := ScrewFromHamilton(NewHamilton(2, 0, 0, 0, 0, 0, 0, 0)); ok {
+		t.Errorf("ScrewFromHamilton of a non-unit value is ok")
+	}
+}
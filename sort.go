@@ -0,0 +1,37 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"cmp"
+	"slices"
+)
+
+// SortByReal sorts v in increasing order by Cmp, that is, by real part and then
+// by dual part. A DualVector can be passed directly.
+func SortByReal(v []Real) {
+	slices.SortFunc(v, func(x, y Real) int {
+		return x.Cmp(&y)
+	})
+}
+
+// SortByQuad sorts v in increasing order of quadrance, breaking ties by Cmp so
+// that the result does not depend on the initial order of v.
+func SortByQuad(v []Real) {
+	slices.SortFunc(v, func(x, y Real) int {
+		if c := cmp.Compare(x.Quad(), y.Quad()); c != 0 {
+			return c
+		}
+		return x.Cmp(&y)
+	})
+}
+
+// SearchReal returns the smallest index i at which v[i] has a real part of at
+// least a, or len(v) if there is none, and whether v[i] has a real part equal
+// to a. The slice v must be sorted as by SortByReal.
+func SearchReal(v []Real, a float64) (int, bool) {
+	return slices.BinarySearchFunc(v, a, func(x Real, a float64) int {
+		return cmp.Compare(x.Real(), a)
+	})
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "testing"
+
+func TestSort(t *testing.T) {
+	v := DualVector{{3, 1}, {-4, 0}, {1, 2}, {1, -2}, {-1, 5}}
+	SortByReal(v)
+	if want := (DualVector{{-4, 0}, {-1, 5}, {1, -2}, {1, 2}, {3, 1}}); !v.Equals(want) {
+		t.Errorf("SortByReal = %v, want %v", v, want)
+	}
+	for _, test := range []struct {
+		a     float64
+		i     int
+		found bool
+	}{
+		{-5, 0, false}, {-4, 0, true}, {0, 2, false}, {1, 2, true}, {3, 4, true}, {9, 5, false},
+	} {
+		if i, found := SearchReal(v, test.a); i != test.i || found != test.found {
+			t.Errorf("SearchReal(%v) = %d, %v, want %d, %v", test.a, i, found, test.i, test.found)
+		}
+	}
+	SortByQuad(v)
+	if want := (DualVector{{-1, 5}, {1, -2}, {1, 2}, {3, 1}, {-4, 0}}); !v.Equals(want) {
+		t.Errorf("SortByQuad = %v, want %v", v, want)
+	}
+}
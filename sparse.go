@@ -0,0 +1,168 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "sort"
+
+// A SparseDualMatrix represents a sparse matrix of dual numbers in compressed
+// sparse row (CSR) form. The column indices of each row are increasing, and
+// only the stored components take up space.
+type SparseDualMatrix struct {
+	rows, cols int
+	indptr     []int
+	indices    []int
+	data       []Real
+}
+
+// NewSparseDualMatrix returns a pointer to a SparseDualMatrix value with r rows
+// and c columns, made from the components v[k] in row i[k] and column j[k].
+// Repeated positions are summed. If the slices do not have the same length, or
+// if a position is out of range, then NewSparseDualMatrix panics.
+func NewSparseDualMatrix(r, c int, i, j []int, v []Real) *SparseDualMatrix {
+	checkLen(len(i), len(j), len(v))
+	order := make([]int, len(v))
+	for k := range order {
+		if i[k] < 0 || i[k] >= r || j[k] < 0 || j[k] >= c {
+			panic("index out of range")
+		}
+		order[k] = k
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		p, q := order[a], order[b]
+		return i[p] < i[q] || (i[p] == i[q] && j[p] < j[q])
+	})
+	z := &SparseDualMatrix{rows: r, cols: c, indptr: make([]int, r+1)}
+	pi, pj := -1, -1
+	for _, k := range order {
+		if i[k] == pi && j[k] == pj {
+			n := len(z.data) - 1
+			z.data[n].Add(&z.data[n], &v[k])
+			continue
+		}
+		z.indices = append(z.indices, j[k])
+		z.data = append(z.data, v[k])
+		z.indptr[i[k]+1]++
+		pi, pj = i[k], j[k]
+	}
+	for k := 0; k < r; k++ {
+		z.indptr[k+1] += z.indptr[k]
+	}
+	return z
+}
+
+// Dims returns the number of rows and columns of z.
+func (z *SparseDualMatrix) Dims() (r, c int) {
+	return z.rows, z.cols
+}
+
+// NNZ returns the number of stored components of z.
+func (z *SparseDualMatrix) NNZ() int {
+	return len(z.data)
+}
+
+// At returns the component of z in row i and column j, a pointer to a Real
+// value. Components that are not stored are zero.
+func (z *SparseDualMatrix) At(i, j int) *Real {
+	if i < 0 || i >= z.rows || j < 0 || j >= z.cols {
+		panic("index out of range")
+	}
+	if k, ok := z.find(i, j); ok {
+		return new(Real).Copy(&z.data[k])
+	}
+	return new(Real)
+}
+
+// Dense returns z as a pointer to a DualMatrix value.
+func (z *SparseDualMatrix) Dense() *DualMatrix {
+	m := NewDualMatrix(z.rows, z.cols, nil)
+	for i := 0; i < z.rows; i++ {
+		for k := z.indptr[i]; k < z.indptr[i+1]; k++ {
+			m.data[i*m.cols+z.indices[k]] = z.data[k]
+		}
+	}
+	return m
+}
+
+// SparseMulVec sets z equal to the product of the sparse matrix x and the
+// column vector y, and returns z.
+func (z DualVector) SparseMulVec(x *SparseDualMatrix, y DualVector) DualVector {
+	checkLen(x.cols, len(y))
+	checkLen(x.rows, len(z))
+	p := make(DualVector, len(z))
+	t := new(Real)
+	for i := range p {
+		for k := x.indptr[i]; k < x.indptr[i+1]; k++ {
+			p[i].Add(&p[i], t.Mul(&x.data[k], &y[x.indices[k]]))
+		}
+	}
+	return z.Copy(p)
+}
+
+// SolveLower returns the solution x of the linear system zx = b, using only
+// the lower triangle of the square matrix z, as a DualVector value. If a
+// diagonal component of z is missing or is a zero divisor, then SolveLower
+// returns ErrZeroDivisor.
+func (z *SparseDualMatrix) SolveLower(b DualVector) (DualVector, error) {
+	if z.rows != z.cols {
+		panic("non-square matrix")
+	}
+	checkLen(z.rows, len(b))
+	x := make(DualVector, len(b))
+	t := new(Real)
+	for i := 0; i < z.rows; i++ {
+		x[i] = b[i]
+		d := -1
+		for k := z.indptr[i]; k < z.indptr[i+1]; k++ {
+			switch j := z.indices[k]; {
+			case j < i:
+				x[i].Sub(&x[i], t.Mul(&z.data[k], &x[j]))
+			case j == i:
+				d = k
+			}
+		}
+		if d < 0 || z.data[d].IsZeroDiv() {
+			return nil, ErrZeroDivisor
+		}
+		x[i].Quo(&x[i], &z.data[d])
+	}
+	return x, nil
+}
+
+// SolveUpper returns the solution x of the linear system zx = b, using only
+// the upper triangle of the square matrix z, as a DualVector value. If a
+// diagonal component of z is missing or is a zero divisor, then SolveUpper
+// returns ErrZeroDivisor.
+func (z *SparseDualMatrix) SolveUpper(b DualVector) (DualVector, error) {
+	if z.rows != z.cols {
+		panic("non-square matrix")
+	}
+	checkLen(z.rows, len(b))
+	x := make(DualVector, len(b))
+	t := new(Real)
+	for i := z.rows - 1; i >= 0; i-- {
+		x[i] = b[i]
+		d := -1
+		for k := z.indptr[i]; k < z.indptr[i+1]; k++ {
+			switch j := z.indices[k]; {
+			case j > i:
+				x[i].Sub(&x[i], t.Mul(&z.data[k], &x[j]))
+			case j == i:
+				d = k
+			}
+		}
+		if d < 0 || z.data[d].IsZeroDiv() {
+			return nil, ErrZeroDivisor
+		}
+		x[i].Quo(&x[i], &z.data[d])
+	}
+	return x, nil
+}
+
+// find returns the index in z.data of the component in row i and column j,
+// and whether it is stored.
+func (z *SparseDualMatrix) find(i, j int) (int, bool) {
+	lo, hi := z.indptr[i], z.indptr[i+1]
+	k := lo + sort.SearchInts(z.indices[lo:hi], j)
+	return k, k < hi && z.indices[k] == j
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "testing"
+
+func TestSparseDualMatrix(t *testing.T) {
+	// [[2+ε 0 0] [1 3 0] [0 1 4]], with the (1, 0) component given in two
+	// parts.
+	m := NewSparseDualMatrix(3, 3,
+		[]int{2, 0, 1, 1, 2, 1},
+		[]int{1, 0, 0, 1, 2, 0},
+		[]Real{{1, 0}, {2, 1}, {0.5, 0}, {3, 0}, {4, 0}, {0.5, 0}},
+	)
+	if m.NNZ() != 5 {
+		t.Errorf("NNZ = %d, want 5", m.NNZ())
+	}
+	if got := m.At(1, 0); !got.Equals(NewReal(1, 0)) {
+		t.Errorf("At(1, 0) = %v", got)
+	}
+	x := DualVector{{1, 0}, {2, 1}, {3, 0}}
+	y := make(DualVector, 3).SparseMulVec(m, x)
+	if want := make(DualVector, 3).MulVec(m.Dense(), x); !y.Equals(want) {
+		t.Errorf("SparseMulVec = %v, want %v", y, want)
+	}
+	if got, err := m.SolveLower(y); err != nil || !got.Equals(x) {
+		t.Errorf("SolveLower = %v, %v, want %v", got, err, x)
+	}
+	u := new(DualMatrix).Transpose(m.Dense())
+	ut := NewSparseDualMatrix(3, 3, []int{0, 0, 1, 1, 2}, []int{0, 1, 1, 2, 2},
+		[]Real{*u.At(0, 0), *u.At(0, 1), *u.At(1, 1), *u.At(1, 2), *u.At(2, 2)})
+	b := make(DualVector, 3).MulVec(u, x)
+	if got, err := ut.SolveUpper(b); err != nil || !got.Equals(x) {
+		t.Errorf("SolveUpper = %v, %v, want %v", got, err, x)
+	}
+	if _, err := NewSparseDualMatrix(2, 2, []int{0}, []int{0}, []Real{{1, 0}}).SolveLower(x[:2]); err != ErrZeroDivisor {
+		t.Errorf("SolveLower missing diagonal: err = %v", err)
+	}
+}
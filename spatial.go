@@ -0,0 +1,155 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+// A Twist represents the spatial velocity of a rigid body as a dual 3-vector
+// ω + εv, with ω the angular velocity and v the linear velocity of the body
+// point at the origin. (*DualVec3)(t) views a Twist t as a DualVec3.
+type Twist [3]Real
+
+// A Wrench represents a spatial force on a rigid body as a dual 3-vector
+// f + ετ, with f the resultant force and τ the torque about the origin.
+// (*DualVec3)(w) views a Wrench w as a DualVec3.
+//
+// In this form, both spatial cross products are the cross product of dual
+// 3-vectors, and both twists and wrenches transform like lines under a rigid
+// motion.
+type Wrench [3]Real
+
+// NewTwist returns a pointer to the Twist value with angular velocity ω and
+// linear velocity v.
+func NewTwist(ω, v [3]float64) *Twist {
+	return (*Twist)(NewDualVec3(ω, v))
+}
+
+// NewWrench returns a pointer to the Wrench value with force f and torque τ.
+func NewWrench(f, τ [3]float64) *Wrench {
+	return (*Wrench)(NewDualVec3(f, τ))
+}
+
+// Angular returns the angular velocity of z, a 3-vector.
+func (z *Twist) Angular() [3]float64 {
+	return (*DualVec3)(z).Real()
+}
+
+// Linear returns the linear velocity of z at the origin, a 3-vector.
+func (z *Twist) Linear() [3]float64 {
+	return (*DualVec3)(z).Dual()
+}
+
+// Force returns the resultant force of z, a 3-vector.
+func (z *Wrench) Force() [3]float64 {
+	return (*DualVec3)(z).Real()
+}
+
+// Torque returns the torque of z about the origin, a 3-vector.
+func (z *Wrench) Torque() [3]float64 {
+	return (*DualVec3)(z).Dual()
+}
+
+// String returns the string version of a Twist value, as that of a DualVec3.
+func (z *Twist) String() string {
+	return (*DualVec3)(z).String()
+}
+
+// String returns the string version of a Wrench value, as that of a DualVec3.
+func (z *Wrench) String() string {
+	return (*DualVec3)(z).String()
+}
+
+// Equals returns true if z and y are equal.
+func (z *Twist) Equals(y *Twist) bool {
+	return (*DualVec3)(z).Equals((*DualVec3)(y))
+}
+
+// Equals returns true if z and y are equal.
+func (z *Wrench) Equals(y *Wrench) bool {
+	return (*DualVec3)(z).Equals((*DualVec3)(y))
+}
+
+// Add sets z equal to the sum of x and y, and returns z.
+func (z *Twist) Add(x, y *Twist) *Twist {
+	(*DualVec3)(z).Add((*DualVec3)(x), (*DualVec3)(y))
+	return z
+}
+
+// Add sets z equal to the sum of x and y, and returns z.
+func (z *Wrench) Add(x, y *Wrench) *Wrench {
+	(*DualVec3)(z).Add((*DualVec3)(x), (*DualVec3)(y))
+	return z
+}
+
+// Scale sets z equal to y scaled by a, and returns z.
+func (z *Twist) Scale(y *Twist, a float64) *Twist {
+	(*DualVec3)(z).Scale((*DualVec3)(y), NewReal(a, 0))
+	return z
+}
+
+// Scale sets z equal to y scaled by a, and returns z.
+func (z *Wrench) Scale(y *Wrench, a float64) *Wrench {
+	(*DualVec3)(z).Scale((*DualVec3)(y), NewReal(a, 0))
+	return z
+}
+
+// Cross sets z equal to the spatial cross product of the twists x and y, the
+// rate of change of y when moved with velocity x, and returns z:
+// 		(ω + εv) × (η + εu) = ω × η + (ω × u + v × η)ε
+func (z *Twist) Cross(x, y *Twist) *Twist {
+	(*DualVec3)(z).Cross((*DualVec3)(x), (*DualVec3)(y))
+	return z
+}
+
+// Cross sets z equal to the spatial cross product of the twist x and the
+// wrench y, the rate of change of y when moved with velocity x, and returns z:
+// 		(ω + εv) × (f + ετ) = ω × f + (ω × τ + v × f)ε
+func (z *Wrench) Cross(x *Twist, y *Wrench) *Wrench {
+	(*DualVec3)(z).Cross((*DualVec3)(x), (*DualVec3)(y))
+	return z
+}
+
+// Transform sets z equal to y moved by the rigid motion encoded in the unit
+// dual quaternion q, as in Line.Transform, and returns z.
+func (z *Twist) Transform(y *Twist, q *Hamilton) *Twist {
+	ω, v := transform3(q, y.Angular(), y.Linear())
+	return z.set(ω, v)
+}
+
+// Transform sets z equal to y moved by the rigid motion encoded in the unit
+// dual quaternion q, as in Line.Transform, and returns z.
+func (z *Wrench) Transform(y *Wrench, q *Hamilton) *Wrench {
+	f, τ := transform3(q, y.Force(), y.Torque())
+	return z.set(f, τ)
+}
+
+// set sets the angular and linear velocities of z, and returns z.
+func (z *Twist) set(ω, v [3]float64) *Twist {
+	for i := range z {
+		z[i].Set(ω[i], v[i])
+	}
+	return z
+}
+
+// set sets the force and torque of z, and returns z.
+func (z *Wrench) set(f, τ [3]float64) *Wrench {
+	for i := range z {
+		z[i].Set(f[i], τ[i])
+	}
+	return z
+}
+
+// Pair returns the dual dot product of the twist z and the wrench w, a pointer
+// to a Real value:
+// 		(ω + εv) · (f + ετ) = ω · f + (ω · τ + v · f)ε
+// The dual part is the power of w acting on a body moving with velocity z, and
+// it does not depend on the choice of origin.
+func (z *Twist) Pair(w *Wrench) *Real {
+	return (*DualVec3)(z).Dot((*DualVec3)(w))
+}
+
+// Power returns the power of the wrench w acting on a body moving with the
+// twist z, the dual part of Pair:
+// 		ω · τ + v · f
+func (z *Twist) Power(w *Wrench) float64 {
+	return dot3(z.Angular(), w.Torque()) + dot3(z.Linear(), w.Force())
+}
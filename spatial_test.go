@@ -0,0 +1,66 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"testing"
+)
+
+// motion returns the unit dual quaternion r + εd for the rotation by θ about
+// the unit axis u followed by the translation t, with d = ½tr.
+func motion(u [3]float64, θ float64, t [3]float64) *Hamilton {
+	return new(Hamilton).Compose(HamiltonTranslation(t), HamiltonRotation(u, θ))
+}
+
+func TestSpatial(t *testing.T) {
+	x := NewTwist([3]float64{1, 2, 3}, [3]float64{-1, 0.5, 2})
+	y := NewTwist([3]float64{0, -1, 4}, [3]float64{3, 1, -2})
+	w := NewWrench([3]float64{2, 0, -1}, [3]float64{1, 1, 1})
+	if got := new(Twist).Cross(x, x); !got.Equals(new(Twist)) {
+		t.Errorf("x × x = %v, want 0", got)
+	}
+	wf := add3(cross3(x.Angular(), w.Torque()), cross3(x.Linear(), w.Force()))
+	if got, want := new(Wrench).Cross(x, w), NewWrench(cross3(x.Angular(), w.Force()), wf); !got.Equals(want) {
+		t.Errorf("x × w = %v, want %v", got, want)
+	}
+	if got, want := x.Pair(w).Dual(), x.Power(w); math.Abs(got-want) > 1e-12 {
+		t.Errorf("dual part of Pair = %v, want Power %v", got, want)
+	}
+	// Translation only: ω' = ω and v' = v + t × ω.
+	tr := [3]float64{1, -2, 0.5}
+	q := motion([3]float64{0, 0, 1}, 0, tr)
+	want := NewTwist(x.Angular(), add3(x.Linear(), cross3(tr, x.Angular())))
+	if got := new(Twist).Transform(x, q); !got.Equals(want) {
+		t.Errorf("translated twist = %v, want %v", got, want)
+	}
+	// The power and the spatial cross product do not depend on the frame.
+	q = motion([3]float64{0, 0.6, 0.8}, 1.2, tr)
+	xq, yq, wq := new(Twist).Transform(x, q), new(Twist).Transform(y, q), new(Wrench).Transform(w, q)
+	if got, want := xq.Power(wq), x.Power(w); math.Abs(got-want) > 1e-12 {
+		t.Errorf("moved power = %v, want %v", got, want)
+	}
+	c := new(Twist).Transform(new(Twist).Cross(x, y), q)
+	if got := new(Twist).Cross(xq, yq); !got.Equals(c) {
+		t.Errorf("moved cross product = %v, want %v", got, c)
+	}
+	s := new(Wrench).Add(w, new(Wrench).Scale(w, 2))
+	if want := new(Wrench).Scale(w, 3); !s.Equals(want) {
+		t.Errorf("w + 2w = %v, want %v", s, want)
+	}
+}
+
+func TestHamiltonRotationTranslation(t *testing.T) {
+	r := HamiltonRotation([3]float64{0, 0, 2}, math.Pi/2)
+	if got, want := rotate3(r, [3]float64{1, 0, 0}), [3]float64{0, 1, 0}; !equalsTol(got[:], want[:], 1e-15) {
+		t.Errorf("rotated x axis = %v, want %v", got, want)
+	}
+	tr := [3]float64{1, -2, 3}
+	if got := translation3(HamiltonTranslation(tr)); got != tr {
+		t.Errorf("translation = %v, want %v", got, tr)
+	}
+	if got := HamiltonRotation([3]float64{}, 1); !got.Equals(NewHamilton(1, 0, 0, 0, 0, 0, 0, 0)) {
+		t.Errorf("rotation about a zero axis = %v, want 1", got)
+	}
+}
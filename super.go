@@ -6,43 +6,56 @@ package dual
 import (
 	"fmt"
 	"math"
-	"strings"
 )
 
-// A Super represents a super dual number as an ordered array of two pointers
-// to Real values.
-type Super [2]*Real
+// A Super represents a super dual number as an ordered array of four float64
+// values: the components of the real part followed by those of the dual part.
+// The zero value is the number zero.
+type Super [4]float64
 
 var (
 	// Symbols for the canonical super dual real basis.
 	symbSuper = [4]string{"", "σ", "τ", "στ"}
+
+	// ASCII symbols for the canonical super dual real basis.
+	symbSuperASCII = [4]string{"", "s1", "s2", "s3"}
+
+	// LaTeX symbols for the canonical super dual real basis.
+	symbSuperLatex = [4]string{"", `\sigma`, `\tau`, `\sigma\tau`}
 )
 
-// Real returns the real part of z, a pointer to a Real value.
+// Real returns the real part of z, a pointer to a Real value that shares
+// storage with z.
 func (z *Super) Real() *Real {
-	return z[0]
+	return (*Real)(z[0:2])
 }
 
-// Dual returns the dual part of z, a pointer to a Real value.
+// Dual returns the dual part of z, a pointer to a Real value that shares
+// storage with z.
 func (z *Super) Dual() *Real {
-	return z[1]
+	return (*Real)(z[2:4])
 }
 
 // SetReal sets the real part of z equal to a.
 func (z *Super) SetReal(a *Real) {
-	z[0] = a
+	z[0], z[1] = a[0], a[1]
 }
 
 // SetDual sets the dual part of z equal to b.
 func (z *Super) SetDual(b *Real) {
-	z[1] = b
+	z[2], z[3] = b[0], b[1]
 }
 
 // Cartesian returns the four Cartesian components of z.
 func (z *Super) Cartesian() (a, b, c, d float64) {
-	a, b = z.Real().Cartesian()
-	c, d = z.Dual().Cartesian()
-	return
+	return z[0], z[1], z[2], z[3]
+}
+
+// Set sets the four Cartesian components of z, in the order returned by
+// Cartesian, and returns z.
+func (z *Super) Set(a, b, c, d float64) *Super {
+	*z = Super{a, b, c, d}
+	return z
 }
 
 // String returns the string representation of a Super value.
@@ -50,50 +63,126 @@ func (z *Super) Cartesian() (a, b, c, d float64) {
 // If z corresponds to the super dual real number a + bσ + cτ + dστ, then the
 // string is "(a+bσ+cτ+dστ)", similar to complex128 values.
 func (z *Super) String() string {
+	return string(z.AppendString(nil))
+}
+
+// AppendString appends the string version of z, as returned by String, to dst
+// and returns the extended buffer.
+func (z *Super) AppendString(dst []byte) []byte {
+	return appendComponents(dst, z[:], symbols(symbSuper[:], symbSuperASCII[:]))
+}
+
+// AppendText implements the encoding.TextAppender interface. It appends the
+// string version of z to b, and never returns an error.
+func (z *Super) AppendText(b []byte) ([]byte, error) {
+	return z.AppendString(b), nil
+}
+
+// MarshalText implements encoding.TextMarshaler; see the package documentation.
+func (z Super) MarshalText() ([]byte, error) {
+	return z.AppendString(nil), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It sets z
+// equal to the value represented by text, with the syntax of ParseSuper. If text
+// is not valid, then z is left unchanged and the error is returned.
+func (z *Super) UnmarshalText(text []byte) error {
+	y, err := ParseSuper(string(text))
+	if err != nil {
+		return err
+	}
+	z.Copy(y)
+	return nil
+}
+
+// Format implements fmt.Formatter; see the package documentation.
+func (z *Super) Format(s fmt.State, verb rune) {
 	v := make([]float64, 4)
 	v[0], v[1], v[2], v[3] = z.Cartesian()
-	a := make([]string, 9)
-	a[0] = "("
-	a[1] = fmt.Sprintf("%g", v[0])
-	i := 1
-	for j := 2; j < 8; j = j + 2 {
-		switch {
-		case math.Signbit(v[i]):
-			a[j] = fmt.Sprintf("%g", v[i])
-		case math.IsInf(v[i], +1):
-			a[j] = "+Inf"
-		default:
-			a[j] = fmt.Sprintf("+%g", v[i])
-		}
-		a[j+1] = symbSuper[i]
-		i++
-	}
-	a[8] = ")"
-	return strings.Join(a, "")
+	symb := symbols(symbSuper[:], symbSuperASCII[:])
+	formatComponents(s, verb, v, symb, z)
 }
 
-// Equals returns true if z and y are equal.
+// Latex returns the LaTeX representation of a Super value.
+//
+// If z corresponds to a + bσ + cτ + dστ, then the string is
+// "a + b\sigma + c\tau + d\sigma\tau", with negative coefficients written with a minus
+// sign, and exponents written as powers of 10.
+func (z *Super) Latex() string {
+	v := make([]float64, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	return latexComponents(v, symbSuperLatex[:])
+}
+
+// FormatWith returns the string version of a Super value, formatted with the
+// options o. A nil o prints the same string as String.
+func (z *Super) FormatWith(o *FormatOptions) string {
+	v := make([]float64, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	return o.format(v, symbSuper[:], symbSuperASCII[:], symbSuperLatex[:])
+}
+
+// GoString returns the Go syntax of a Super value, as a call to NewSuper such as
+// "dual.NewSuper(1, 2, 3, 4)". It is used by the "%#v" verb.
+func (z *Super) GoString() string {
+	v := make([]float64, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	return goCall("dual.NewSuper", v...)
+}
+
+// Equals returns true if z and y are equal, within the absolute tolerance
+// Tolerance.
 func (z *Super) Equals(y *Super) bool {
-	if !z.Real().Equals(y.Real()) || !z.Dual().Equals(y.Dual()) {
-		return false
-	}
-	return true
+	return z.EqualsTol(y, Tolerance)
+}
+
+// EqualsTol returns true if each component of z differs from the matching
+// component of y by at most tol.
+func (z *Super) EqualsTol(y *Super, tol float64) bool {
+	return equalsTol(z[:], y[:], tol)
+}
+
+// Equal returns true if each component of z has the same bits as the matching
+// component of y. Unlike Equals, it tells 0 from -0, and a NaN is equal to
+// itself.
+func (z *Super) Equal(y *Super) bool {
+	return equalBits(z[:], y[:])
+}
+
+// Hash64 returns a map-key hash of z; see the package documentation.
+func (z *Super) Hash64(step float64) uint64 {
+	return hash64(z[:], step)
+}
+
+// Cmp compares z and y in lexicographic order of their components, as given by
+// Cartesian, and returns -1, 0, or +1 as z is less than, equal to, or greater
+// than y. Each pair of components is compared as by cmp.Compare, so 0 equals -0
+// and a NaN is less than any other value and equal to another NaN. This is a
+// total order for sorting and searching, unrelated to the algebra.
+func (z *Super) Cmp(y *Super) int {
+	return compare(z[:], y[:])
+}
+
+// Diff returns how z differs from y; see the package documentation.
+func (z *Super) Diff(y *Super) Diff {
+	return diff(z[:], y[:], symbols(symbSuper[:], symbSuperASCII[:]))
+}
+
+// EqualsULP compares z and y in ULPs; see the package documentation.
+func (z *Super) EqualsULP(y *Super, ulps uint) bool {
+	return equalsULP(z[:], y[:], ulps)
 }
 
 // Copy copies y onto z, and returns z.
 func (z *Super) Copy(y *Super) *Super {
-	z.SetReal(new(Real).Copy(y.Real()))
-	z.SetDual(new(Real).Copy(y.Dual()))
+	*z = *y
 	return z
 }
 
 // NewSuper returns a pointer to a Super value made from four given float64
 // values.
 func NewSuper(a, b, c, d float64) *Super {
-	z := new(Super)
-	z.SetReal(NewReal(a, b))
-	z.SetDual(NewReal(c, d))
-	return z
+	return &Super{a, b, c, d}
 }
 
 // IsInf returns true if any of the components of z are infinite.
@@ -106,10 +195,7 @@ func (z *Super) IsInf() bool {
 
 // SuperInf returns a pointer to a super dual infinity value.
 func SuperInf(a, b, c, d int) *Super {
-	z := new(Super)
-	z.SetReal(RealInf(a, b))
-	z.SetDual(RealInf(c, d))
-	return z
+	return &Super{math.Inf(a), math.Inf(b), math.Inf(c), math.Inf(d)}
 }
 
 // IsNaN returns true if any component of z is NaN and neither is an
@@ -126,56 +212,76 @@ func (z *Super) IsNaN() bool {
 
 // SuperNaN returns a pointer to a super dual NaN value.
 func SuperNaN() *Super {
-	z := new(Super)
-	z.SetReal(RealNaN())
-	z.SetDual(RealNaN())
-	return z
+	nan := math.NaN()
+	return &Super{nan, nan, nan, nan}
+}
+
+// IsFinite returns true if every component of z is finite, that is, neither
+// infinite nor NaN.
+func (z *Super) IsFinite() bool {
+	v := z[:]
+	return isFinite(v)
+}
+
+// Validate returns nil if every component of z is finite, and otherwise a
+// *NonFiniteError that reports the first component that is infinite or NaN.
+func (z *Super) Validate() error {
+	v := z[:]
+	return validate(v, symbols(symbSuper[:], symbSuperASCII[:]))
 }
 
 // Scal sets z equal to y scaled by a (with a being a Real pointer),
 // and returns z.
 //
 // This is a special case of Mul:
-// 		Scal(y, a) = Mul(y, Super{a, 0})
+// 		Scal(y, a) = Mul(y, Super{a[0], a[1], 0, 0})
 func (z *Super) Scal(y *Super, a *Real) *Super {
-	z.SetReal(new(Real).Mul(y.Real(), a))
-	z.SetDual(new(Real).Mul(y.Dual(), a))
+	b := *a
+	z.Real().Mul(y.Real(), &b)
+	z.Dual().Mul(y.Dual(), &b)
 	return z
 }
 
 // Dil sets z equal to the dilation of y by a, and returns z.
 //
 // This is a special case of Mul:
-// 		Dil(y, a) = Mul(y, Super{Real{a, 0}, 0})
+// 		Dil(y, a) = Mul(y, Super{a, 0, 0, 0})
 func (z *Super) Dil(y *Super, a float64) *Super {
-	z.SetReal(new(Real).Scal(y.Real(), a))
-	z.SetDual(new(Real).Scal(y.Dual(), a))
+	for i := range z {
+		z[i] = y[i] * a
+	}
 	return z
 }
 
-// Neg sets z equal to the negative of y, and returns z.
+// Neg sets z equal to the negative of y, and returns z. It flips the sign of
+// each component, zeros included.
 func (z *Super) Neg(y *Super) *Super {
-	return z.Dil(y, -1)
+	for i := range z {
+		z[i] = -y[i]
+	}
+	return z
 }
 
 // Conj sets z equal to the conjugate of y, and returns z.
 func (z *Super) Conj(y *Super) *Super {
-	z.SetReal(new(Real).Conj(y.Real()))
-	z.SetDual(new(Real).Neg(y.Dual()))
+	z.Real().Conj(y.Real())
+	z.Dual().Neg(y.Dual())
 	return z
 }
 
 // Add sets z equal to the sum of x and y, and returns z.
 func (z *Super) Add(x, y *Super) *Super {
-	z.SetReal(new(Real).Add(x.Real(), y.Real()))
-	z.SetDual(new(Real).Add(x.Dual(), y.Dual()))
+	for i := range z {
+		z[i] = x[i] + y[i]
+	}
 	return z
 }
 
 // Sub sets z equal to the difference of x and y, and returns z.
 func (z *Super) Sub(x, y *Super) *Super {
-	z.SetReal(new(Real).Sub(x.Real(), y.Real()))
-	z.SetDual(new(Real).Sub(x.Dual(), y.Dual()))
+	for i := range z {
+		z[i] = x[i] - y[i]
+	}
 	return z
 }
 
@@ -189,12 +295,32 @@ func (z *Super) Sub(x, y *Super) *Super {
 //      τ * στ = στ * τ = 0
 // This multiplication operation is noncommutative but associative.
 func (z *Super) Mul(x, y *Super) *Super {
-	p := new(Super).Copy(x)
-	q := new(Super).Copy(y)
-	z.SetReal(new(Real).Mul(p.Real(), q.Real()))
-	z.SetDual(new(Real).Add(
-		new(Real).Mul(q.Dual(), p.Real()),
-		new(Real).Mul(p.Dual(), q.Real().Conj(q.Real()))))
+	p, q := *x, *y
+	var s, t Real
+	if *q.Dual() != (Real{}) || hasNaN(p.Real()[:]...) {
+		s.Mul(q.Dual(), p.Real())
+	}
+	if *p.Dual() != (Real{}) || hasNaN(q.Real()[:]...) {
+		t.Mul(p.Dual(), t.Conj(q.Real()))
+	}
+	z.Real().Mul(p.Real(), q.Real())
+	z.Dual().Add(&s, &t)
+	return z
+}
+
+// MulAdd sets z equal to x*y + w, and returns z. The products of the Real
+// parts are accumulated with Real.MulAdd.
+func (z *Super) MulAdd(x, y, w *Super) *Super {
+	p, q, v := *x, *y, *w
+	z.Real().MulAdd(p.Real(), q.Real(), v.Real())
+	z.SetDual(v.Dual())
+	if *q.Dual() != (Real{}) || hasNaN(p.Real()[:]...) {
+		z.Dual().MulAdd(q.Dual(), p.Real(), z.Dual())
+	}
+	if *p.Dual() != (Real{}) || hasNaN(q.Real()[:]...) {
+		var c Real
+		z.Dual().MulAdd(p.Dual(), c.Conj(q.Real()), z.Dual())
+	}
 	return z
 }
 
@@ -203,8 +329,36 @@ func (z *Super) Commutator(x, y *Super) *Super {
 	return z.Sub(new(Super).Mul(x, y), new(Super).Mul(y, x))
 }
 
+// Associator sets z equal to the associator of w, x, and y, and returns z.
+// Since Mul is associative, the associator is zero up to rounding; it serves to
+// verify that claim on given values.
+func (z *Super) Associator(w, x, y *Super) *Super {
+	return z.Sub(
+		new(Super).Mul(new(Super).Mul(w, x), y),
+		new(Super).Mul(w, new(Super).Mul(x, y)),
+	)
+}
+
 // Quad returns the dual quadrance of z, a float64 value.
 func (z *Super) Quad() float64 {
 	a := z.Real().Real()
 	return a * a
 }
+
+// DualConj sets z equal to the dual conjugate of y, which negates the dual
+// part, and returns z:
+// 		DualConj(a + bσ + cτ + dστ) = a + bσ - cτ - dστ
+func (z *Super) DualConj(y *Super) *Super {
+	z.Real().Copy(y.Real())
+	z.Dual().Neg(y.Dual())
+	return z
+}
+
+// DualQuad returns the dual quadrance of z, a pointer to a Real value. It is
+// the quadrance computed with the τ-dual number a + cτ as coefficient, and its
+// dual part is the τ part:
+// 		DualQuad(a + bσ + cτ + dστ) = a² + 2acτ
+func (z *Super) DualQuad() *Real {
+	a, _, c, _ := z.Cartesian()
+	return NewReal(a*a, 2*a*c)
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "testing"
+
+func TestFlatMul(t *testing.T) {
+	σ, τ := NewSuper(0, 1, 0, 0), NewSuper(0, 0, 1, 0)
+	if got := new(Super).Mul(σ, τ); !got.Equals(NewSuper(0, 0, 0, 1)) {
+		t.Errorf("σ * τ = %v", got)
+	}
+	if got := new(Super).Mul(τ, σ); !got.Equals(NewSuper(0, 0, 0, -1)) {
+		t.Errorf("τ * σ = %v", got)
+	}
+	u1, u4 := NewUltra(0, 1, 0, 0, 0, 0, 0, 0), NewUltra(0, 0, 0, 0, 1, 0, 0, 0)
+	if got := new(Ultra).Mul(u1, u4); !got.Equals(NewUltra(0, 0, 0, 0, 0, 1, 0, 0)) {
+		t.Errorf("υ₁ * υ₄ = %v", got)
+	}
+	x, y := NewHyper(1, 2, 3, 4), NewHyper(5, 6, 7, 8)
+	if got := new(Hyper).Mul(x, y); !got.Equals(NewHyper(5, 16, 22, 60)) {
+		t.Errorf("Hyper Mul = %v", got)
+	}
+	var z Ultra
+	p, q := NewUltra(1, 2, 3, 4, 5, 6, 7, 8), NewUltra(8, 7, 6, 5, 4, 3, 2, 1)
+	if n := testing.AllocsPerRun(100, func() { z.Mul(p, q) }); n != 0 {
+		t.Errorf("Ultra Mul allocates %v times", n)
+	}
+}
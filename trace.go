@@ -0,0 +1,153 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"strconv"
+	"strings"
+)
+
+// A TraceOp is one operation recorded on a Tape: its name, its operands, and
+// its result.
+type TraceOp struct {
+	Op     string
+	Args   []Real
+	Result Real
+}
+
+// String returns the string version of a TraceOp value, such as
+// "Mul((1+2ε), (3+0ε)) = (3+6ε)".
+func (o *TraceOp) String() string {
+	a := make([]string, len(o.Args))
+	for i := range o.Args {
+		a[i] = o.Args[i].String()
+	}
+	return o.Op + "(" + strings.Join(a, ", ") + ") = " + o.Result.String()
+}
+
+// A Tape records the operations of a computation on Real values, to find where
+// a NaN or a wrong derivative first appears. The methods of a Tape perform the
+// operation of the Real method of the same name and record it; for example,
+// t.Mul(z, x, y) sets z to the product of x and y, as z.Mul(x, y) does. The
+// methods of a nil *Tape perform the operations without recording them, so
+// tracing can be turned off without changing the computation.
+type Tape struct {
+	Ops []TraceOp
+}
+
+// Record appends the operation op with the operands args and the result z to
+// t, and returns z. It records operations that have no method on Tape.
+func (t *Tape) Record(op string, z *Real, args ...*Real) *Real {
+	if t == nil {
+		return z
+	}
+	o := TraceOp{Op: op, Args: make([]Real, len(args)), Result: *z}
+	for i, x := range args {
+		o.Args[i] = *x
+	}
+	t.Ops = append(t.Ops, o)
+	return z
+}
+
+// Add sets z equal to the sum of x and y, records it, and returns z.
+func (t *Tape) Add(z, x, y *Real) *Real {
+	a, b := *x, *y
+	return t.Record("Add", z.Add(x, y), &a, &b)
+}
+
+// Sub sets z equal to the difference of x and y, records it, and returns z.
+func (t *Tape) Sub(z, x, y *Real) *Real {
+	a, b := *x, *y
+	return t.Record("Sub", z.Sub(x, y), &a, &b)
+}
+
+// Mul sets z equal to the product of x and y, records it, and returns z.
+func (t *Tape) Mul(z, x, y *Real) *Real {
+	a, b := *x, *y
+	return t.Record("Mul", z.Mul(x, y), &a, &b)
+}
+
+// Quo sets z equal to the quotient of x and y, records it, and returns z. If y
+// is a zero divisor, then Quo sets z to NaN, as QuoNaN does, so that the tape
+// shows where the failure happened.
+func (t *Tape) Quo(z, x, y *Real) *Real {
+	a, b := *x, *y
+	return t.Record("Quo", z.QuoNaN(x, y), &a, &b)
+}
+
+// Neg sets z equal to the negative of y, records it, and returns z.
+func (t *Tape) Neg(z, y *Real) *Real {
+	a := *y
+	return t.Record("Neg", z.Neg(y), &a)
+}
+
+// Inv sets z equal to the inverse of y, records it, and returns z. If y is a
+// zero divisor, then Inv sets z to NaN, as InvNaN does.
+func (t *Tape) Inv(z, y *Real) *Real {
+	a := *y
+	return t.Record("Inv", z.InvNaN(y), &a)
+}
+
+// Sin sets z equal to the dual sine of y, records it, and returns z.
+func (t *Tape) Sin(z, y *Real) *Real {
+	a := *y
+	return t.Record("Sin", z.Sin(y), &a)
+}
+
+// Cos sets z equal to the dual cosine of y, records it, and returns z.
+func (t *Tape) Cos(z, y *Real) *Real {
+	a := *y
+	return t.Record("Cos", z.Cos(y), &a)
+}
+
+// Exp sets z equal to the dual exponential of y, records it, and returns z.
+func (t *Tape) Exp(z, y *Real) *Real {
+	a := *y
+	return t.Record("Exp", z.Exp(y), &a)
+}
+
+// FirstNonFinite returns the index of the first operation on t whose operands
+// are all finite but whose result is not, the place where an infinity or a NaN
+// first appears. If there is no such operation, then FirstNonFinite returns
+// -1, as it does for a nil t.
+func (t *Tape) FirstNonFinite() int {
+	if t == nil {
+		return -1
+	}
+	for i := range t.Ops {
+		o := &t.Ops[i]
+		if isFinite(o.Result[:]) {
+			continue
+		}
+		finite := true
+		for j := range o.Args {
+			finite = finite && isFinite(o.Args[j][:])
+		}
+		if finite {
+			return i
+		}
+	}
+	return -1
+}
+
+// String returns the operations on t, one per line, numbered from 0. It returns
+// the empty string for a nil t.
+func (t *Tape) String() string {
+	if t == nil {
+		return ""
+	}
+	var b strings.Builder
+	for i := range t.Ops {
+		b.WriteString(strconv.Itoa(i) + ": " + t.Ops[i].String() + "\n")
+	}
+	return b.String()
+}
+
+// Reset removes all the operations from t. It does nothing for a nil t.
+func (t *Tape) Reset() {
+	if t == nil {
+		return
+	}
+	t.Ops = t.Ops[:0]
+}
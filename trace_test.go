@@ -0,0 +1,51 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTape(t *testing.T) {
+	var tape Tape
+	x := NewReal(1, 1)
+	y := new(Real)
+	tape.Mul(y, x, x)
+	tape.Sub(y, y, NewReal(1, 0))
+	tape.Quo(y, x, y)
+	tape.Exp(y, y)
+	if len(tape.Ops) != 4 {
+		t.Fatalf("recorded %d operations, want 4", len(tape.Ops))
+	}
+	if got, want := tape.Ops[0].String(), "Mul((1+1ε), (1+1ε)) = (1+2ε)"; got != want {
+		t.Errorf("first operation = %q, want %q", got, want)
+	}
+	// The operands are recorded before the result overwrites them.
+	if got := &tape.Ops[1].Args[0]; !got.Equals(NewReal(1, 2)) {
+		t.Errorf("operand of Sub = %v, want (1+2ε)", got)
+	}
+	if got := tape.FirstNonFinite(); got != 2 {
+		t.Errorf("FirstNonFinite = %d, want 2\n%v", got, &tape)
+	}
+	if !math.IsNaN(y.Real()) {
+		t.Errorf("result = %v, want NaN", y)
+	}
+	tape.Reset()
+	if got := tape.FirstNonFinite(); got != -1 {
+		t.Errorf("FirstNonFinite of an empty tape = %d, want -1", got)
+	}
+	// A nil tape computes without recording.
+	var none *Tape
+	if got := none.Add(new(Real), x, x); !got.Equals(NewReal(2, 2)) {
+		t.Errorf("nil tape Add = %v, want (2+2ε)", got)
+	}
+	none.Reset()
+	if got := none.FirstNonFinite(); got != -1 {
+		t.Errorf("nil tape FirstNonFinite = %d, want -1", got)
+	}
+	if got := none.String(); got != "" {
+		t.Errorf("nil tape String = %q, want \"\"", got)
+	}
+}
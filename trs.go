@@ -0,0 +1,75 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "math"
+
+// A TRS is the transform of a node as stored by glTF and most game asset
+// pipelines: the scale, then the rotation, then the translation. The rotation
+// is a unit quaternion in the glTF order (x, y, z, w), with w the scalar part.
+type TRS struct {
+	Translation [3]float64
+	Rotation    [4]float64
+	Scale       [3]float64
+}
+
+// TRS returns the transform of the unit dual quaternion z, with unit scale.
+func (z *Hamilton) TRS() TRS {
+	a, b, c, d, _, _, _, _ := z.Cartesian()
+	return TRS{
+		Translation: translation3(z),
+		Rotation:    [4]float64{b, c, d, a},
+		Scale:       [3]float64{1, 1, 1},
+	}
+}
+
+// SetTRS sets z equal to the unit dual quaternion of the rotation and the
+// translation of x, and returns z. A unit dual quaternion has no scale, so the
+// scale of x is left out; a caller that needs it keeps it apart. The rotation
+// is normalized.
+func (z *Hamilton) SetTRS(x *TRS) *Hamilton {
+	r := x.Rotation
+	n := math.Sqrt((r[0] * r[0]) + (r[1] * r[1]) + (r[2] * r[2]) + (r[3] * r[3]))
+	q := NewHamilton(r[3]/n, r[0]/n, r[1]/n, r[2]/n, 0, 0, 0, 0)
+	return z.Compose(HamiltonTranslation(x.Translation), q)
+}
+
+// HamiltonChannels returns the translation and rotation channels of the poses
+// q, as the flat float32 arrays of the output accessors of a glTF animation
+// sampler: three components per pose for the translation, and four, in the
+// order (x, y, z, w), for the rotation.
+func HamiltonChannels(q []Hamilton) (translation, rotation []float32) {
+	translation = make([]float32, 0, 3*len(q))
+	rotation = make([]float32, 0, 4*len(q))
+	for i := range q {
+		x := q[i].TRS()
+		for _, v := range x.Translation {
+			translation = append(translation, float32(v))
+		}
+		for _, v := range x.Rotation {
+			rotation = append(rotation, float32(v))
+		}
+	}
+	return translation, rotation
+}
+
+// HamiltonFromChannels returns the poses with the glTF translation and
+// rotation channels translation and rotation, the inverse of
+// HamiltonChannels. If the channels do not hold the same number of poses,
+// then HamiltonFromChannels panics.
+func HamiltonFromChannels(translation, rotation []float32) []Hamilton {
+	if len(translation)%3 != 0 || len(rotation)%4 != 0 {
+		panic("mismatched lengths")
+	}
+	checkLen(len(translation)/3, len(rotation)/4)
+	q := make([]Hamilton, len(translation)/3)
+	for i := range q {
+		t, r := translation[3*i:], rotation[4*i:]
+		q[i].SetTRS(&TRS{
+			Translation: [3]float64{float64(t[0]), float64(t[1]), float64(t[2])},
+			Rotation:    [4]float64{float64(r[0]), float64(r[1]), float64(r[2]), float64(r[3])},
+		})
+	}
+	return q
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTRS(t *testing.T) {
+	const tol = 1e-12
+	q := motion([3]float64{0, 0, 1}, math.Pi/2, [3]float64{1, -2, 3})
+	x := q.TRS()
+	s := math.Sqrt(0.5)
+	want := TRS{[3]float64{1, -2, 3}, [4]float64{0, 0, s, s}, [3]float64{1, 1, 1}}
+	if !equalsTol(x.Translation[:], want.Translation[:], tol) ||
+		!equalsTol(x.Rotation[:], want.Rotation[:], tol) || x.Scale != want.Scale {
+		t.Errorf("TRS = %v, want %v", x, want)
+	}
+	x.Rotation = [4]float64{0, 0, 2, 2}
+	if got := new(Hamilton).SetTRS(&x); !got.EqualsTol(q, tol) {
+		t.Errorf("SetTRS = %v, want %v", got, q)
+	}
+	poses := []Hamilton{*q, *motion([3]float64{1, 0, 0}, 0.3, [3]float64{0, 0, 1})}
+	tr, rot := HamiltonChannels(poses)
+	if len(tr) != 6 || len(rot) != 8 {
+		t.Fatalf("channel lengths = %d, %d, want 6, 8", len(tr), len(rot))
+	}
+	got := HamiltonFromChannels(tr, rot)
+	for i := range poses {
+		if !got[i].EqualsTol(&poses[i], 1e-6) {
+			t.Errorf("pose %d = %v, want %v", i, &got[i], &poses[i])
+		}
+	}
+}
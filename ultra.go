@@ -3,46 +3,59 @@
 
 package dual
 
-import (
-	"fmt"
-	"math"
-	"strings"
-)
+import "fmt"
 
-// An Ultra represents an ultra dual number as an ordered array of two pointers
-// to Super values.
-type Ultra [2]*Super
+// An Ultra represents an ultra dual number as an ordered array of eight float64
+// values: the components of the real part followed by those of the dual part.
+// The zero value is the number zero.
+type Ultra [8]float64
 
 var (
 	// Symbols for the canonical ultra dual real basis.
 	symbUltra = [8]string{"", "υ₁", "υ₂", "υ₃", "υ₄", "υ₅", "υ₆", "υ₇"}
+
+	// ASCII symbols for the canonical ultra dual real basis.
+	symbUltraASCII = [8]string{"", "u1", "u2", "u3", "u4", "u5", "u6", "u7"}
+
+	// LaTeX symbols for the canonical ultra dual real basis.
+	symbUltraLatex = [8]string{
+		"", `\upsilon_{1}`, `\upsilon_{2}`, `\upsilon_{3}`,
+		`\upsilon_{4}`, `\upsilon_{5}`, `\upsilon_{6}`, `\upsilon_{7}`,
+	}
 )
 
-// Real returns the real part of z, a pointer to a Super value.
+// Real returns the real part of z, a pointer to a Super value that shares
+// storage with z.
 func (z *Ultra) Real() *Super {
-	return z[0]
+	return (*Super)(z[0:4])
 }
 
-// Dual returns the dual part of z, a pointer to a Super value.
+// Dual returns the dual part of z, a pointer to a Super value that shares
+// storage with z.
 func (z *Ultra) Dual() *Super {
-	return z[1]
+	return (*Super)(z[4:8])
 }
 
 // SetReal sets the real part of z equal to a.
 func (z *Ultra) SetReal(a *Super) {
-	z[0] = a
+	copy(z[0:4], a[:])
 }
 
 // SetDual sets the dual part of z equal to b.
 func (z *Ultra) SetDual(b *Super) {
-	z[1] = b
+	copy(z[4:8], b[:])
 }
 
 // Cartesian returns the four Cartesian components of z.
 func (z *Ultra) Cartesian() (a, b, c, d, e, f, g, h float64) {
-	a, b, c, d = z.Real().Cartesian()
-	e, f, g, h = z.Dual().Cartesian()
-	return
+	return z[0], z[1], z[2], z[3], z[4], z[5], z[6], z[7]
+}
+
+// Set sets the eight Cartesian components of z, in the order returned by
+// Cartesian, and returns z.
+func (z *Ultra) Set(a, b, c, d, e, f, g, h float64) *Ultra {
+	*z = Ultra{a, b, c, d, e, f, g, h}
+	return z
 }
 
 // String returns the string representation of a Ultra value.
@@ -50,51 +63,126 @@ func (z *Ultra) Cartesian() (a, b, c, d, e, f, g, h float64) {
 // If z corresponds to the ultra dual real number a + bσ + cτ + dστ, then the
 // string is "(a+bσ+cτ+dστ)", similar to complex128 values.
 func (z *Ultra) String() string {
-	v := make([]float64, 8)
-	v[0], v[1], v[2], v[3] = z.Real().Cartesian()
-	v[4], v[5], v[6], v[7] = z.Dual().Cartesian()
-	a := make([]string, 17)
-	a[0] = "("
-	a[1] = fmt.Sprintf("%g", v[0])
-	i := 1
-	for j := 2; j < 16; j = j + 2 {
-		switch {
-		case math.Signbit(v[i]):
-			a[j] = fmt.Sprintf("%g", v[i])
-		case math.IsInf(v[i], +1):
-			a[j] = "+Inf"
-		default:
-			a[j] = fmt.Sprintf("+%g", v[i])
-		}
-		a[j+1] = symbUltra[i]
-		i++
+	return string(z.AppendString(nil))
+}
+
+// AppendString appends the string version of z, as returned by String, to dst
+// and returns the extended buffer.
+func (z *Ultra) AppendString(dst []byte) []byte {
+	return appendComponents(dst, z[:], symbols(symbUltra[:], symbUltraASCII[:]))
+}
+
+// AppendText implements the encoding.TextAppender interface. It appends the
+// string version of z to b, and never returns an error.
+func (z *Ultra) AppendText(b []byte) ([]byte, error) {
+	return z.AppendString(b), nil
+}
+
+// MarshalText implements encoding.TextMarshaler; see the package documentation.
+func (z Ultra) MarshalText() ([]byte, error) {
+	return z.AppendString(nil), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It sets z
+// equal to the value represented by text, with the syntax of ParseUltra. If text
+// is not valid, then z is left unchanged and the error is returned.
+func (z *Ultra) UnmarshalText(text []byte) error {
+	y, err := ParseUltra(string(text))
+	if err != nil {
+		return err
 	}
-	a[16] = ")"
-	return strings.Join(a, "")
+	z.Copy(y)
+	return nil
+}
+
+// Format implements fmt.Formatter; see the package documentation.
+func (z *Ultra) Format(s fmt.State, verb rune) {
+	v := make([]float64, 8)
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = z.Cartesian()
+	symb := symbols(symbUltra[:], symbUltraASCII[:])
+	formatComponents(s, verb, v, symb, z)
+}
+
+// Latex returns the LaTeX representation of a Ultra value.
+//
+// If z corresponds to a + bυ₁ + cυ₂ + dυ₃ + eυ₄ + fυ₅ + gυ₆ + hυ₇, then the string is
+// "a + b\upsilon_{1} + ... + h\upsilon_{7}", with negative coefficients written with a minus
+// sign, and exponents written as powers of 10.
+func (z *Ultra) Latex() string {
+	v := make([]float64, 8)
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = z.Cartesian()
+	return latexComponents(v, symbUltraLatex[:])
+}
+
+// FormatWith returns the string version of a Ultra value, formatted with the
+// options o. A nil o prints the same string as String.
+func (z *Ultra) FormatWith(o *FormatOptions) string {
+	v := make([]float64, 8)
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = z.Cartesian()
+	return o.format(v, symbUltra[:], symbUltraASCII[:], symbUltraLatex[:])
 }
 
-// Equals returns true if z and y are equal.
+// GoString returns the Go syntax of a Ultra value, as a call to NewUltra such as
+// "dual.NewUltra(1, 2, 3, 4, 5, 6, 7, 8)". It is used by the "%#v" verb.
+func (z *Ultra) GoString() string {
+	v := make([]float64, 8)
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = z.Cartesian()
+	return goCall("dual.NewUltra", v...)
+}
+
+// Equals returns true if z and y are equal, within the absolute tolerance
+// Tolerance.
 func (z *Ultra) Equals(y *Ultra) bool {
-	if !z.Real().Equals(y.Real()) || !z.Dual().Equals(y.Dual()) {
-		return false
-	}
-	return true
+	return z.EqualsTol(y, Tolerance)
+}
+
+// EqualsTol returns true if each component of z differs from the matching
+// component of y by at most tol.
+func (z *Ultra) EqualsTol(y *Ultra, tol float64) bool {
+	return equalsTol(z[:], y[:], tol)
+}
+
+// Equal returns true if each component of z has the same bits as the matching
+// component of y. Unlike Equals, it tells 0 from -0, and a NaN is equal to
+// itself.
+func (z *Ultra) Equal(y *Ultra) bool {
+	return equalBits(z[:], y[:])
+}
+
+// Hash64 returns a map-key hash of z; see the package documentation.
+func (z *Ultra) Hash64(step float64) uint64 {
+	return hash64(z[:], step)
+}
+
+// Cmp compares z and y in lexicographic order of their components, as given by
+// Cartesian, and returns -1, 0, or +1 as z is less than, equal to, or greater
+// than y. Each pair of components is compared as by cmp.Compare, so 0 equals -0
+// and a NaN is less than any other value and equal to another NaN. This is a
+// total order for sorting and searching, unrelated to the algebra.
+func (z *Ultra) Cmp(y *Ultra) int {
+	return compare(z[:], y[:])
+}
+
+// Diff returns how z differs from y; see the package documentation.
+func (z *Ultra) Diff(y *Ultra) Diff {
+	return diff(z[:], y[:], symbols(symbUltra[:], symbUltraASCII[:]))
+}
+
+// EqualsULP compares z and y in ULPs; see the package documentation.
+func (z *Ultra) EqualsULP(y *Ultra, ulps uint) bool {
+	return equalsULP(z[:], y[:], ulps)
 }
 
 // Copy copies y onto z, and returns z.
 func (z *Ultra) Copy(y *Ultra) *Ultra {
-	z.SetReal(new(Super).Copy(y.Real()))
-	z.SetDual(new(Super).Copy(y.Dual()))
+	*z = *y
 	return z
 }
 
 // NewUltra returns a pointer to a Ultra value made from eight given float64
 // values.
 func NewUltra(a, b, c, d, e, f, g, h float64) *Ultra {
-	z := new(Ultra)
-	z.SetReal(NewSuper(a, b, c, d))
-	z.SetDual(NewSuper(e, f, g, h))
-	return z
+	return &Ultra{a, b, c, d, e, f, g, h}
 }
 
 // IsInf returns true if any of the components of z are infinite.
@@ -133,50 +221,72 @@ func UltraNaN() *Ultra {
 	return z
 }
 
+// IsFinite returns true if every component of z is finite, that is, neither
+// infinite nor NaN.
+func (z *Ultra) IsFinite() bool {
+	v := z[:]
+	return isFinite(v)
+}
+
+// Validate returns nil if every component of z is finite, and otherwise a
+// *NonFiniteError that reports the first component that is infinite or NaN.
+func (z *Ultra) Validate() error {
+	v := z[:]
+	return validate(v, symbols(symbUltra[:], symbUltraASCII[:]))
+}
+
 // Scal sets z equal to y scaled by a (with a being a Super pointer),
 // and returns z.
 //
 // This is a special case of Mul:
-// 		Scal(y, a) = Mul(y, Ultra{a, 0})
+// 		Scal(y, a) = Mul(y, Ultra{a[0], a[1], a[2], a[3], 0, 0, 0, 0})
 func (z *Ultra) Scal(y *Ultra, a *Super) *Ultra {
-	z.SetReal(new(Super).Mul(y.Real(), a))
-	z.SetDual(new(Super).Mul(y.Dual(), a))
+	b := *a
+	z.Real().Mul(y.Real(), &b)
+	z.Dual().Mul(y.Dual(), &b)
 	return z
 }
 
 // Dil sets z equal to the dilation of y by a, and returns z.
 //
 // This is a special case of Mul:
-// 		Dil(y, a) = Mul(y, Ultra{Super{a, 0}, 0})
+// 		Dil(y, a) = Mul(y, Ultra{a, 0, 0, 0, 0, 0, 0, 0})
 func (z *Ultra) Dil(y *Ultra, a float64) *Ultra {
-	z.SetReal(new(Super).Dil(y.Real(), a))
-	z.SetDual(new(Super).Dil(y.Dual(), a))
+	for i := range z {
+		z[i] = y[i] * a
+	}
 	return z
 }
 
-// Neg sets z equal to the negative of y, and returns z.
+// Neg sets z equal to the negative of y, and returns z. It flips the sign of
+// each component, zeros included.
 func (z *Ultra) Neg(y *Ultra) *Ultra {
-	return z.Dil(y, -1)
+	for i := range z {
+		z[i] = -y[i]
+	}
+	return z
 }
 
 // Conj sets z equal to the conjugate of y, and returns z.
 func (z *Ultra) Conj(y *Ultra) *Ultra {
-	z.SetReal(new(Super).Conj(y.Real()))
-	z.SetDual(new(Super).Neg(y.Dual()))
+	z.Real().Conj(y.Real())
+	z.Dual().Neg(y.Dual())
 	return z
 }
 
 // Add sets z equal to the sum of x and y, and returns z.
 func (z *Ultra) Add(x, y *Ultra) *Ultra {
-	z.SetReal(new(Super).Add(x.Real(), y.Real()))
-	z.SetDual(new(Super).Add(x.Dual(), y.Dual()))
+	for i := range z {
+		z[i] = x[i] + y[i]
+	}
 	return z
 }
 
 // Sub sets z equal to the difference of x and y, and returns z.
 func (z *Ultra) Sub(x, y *Ultra) *Ultra {
-	z.SetReal(new(Super).Sub(x.Real(), y.Real()))
-	z.SetDual(new(Super).Sub(x.Dual(), y.Dual()))
+	for i := range z {
+		z[i] = x[i] - y[i]
+	}
 	return z
 }
 
@@ -192,13 +302,32 @@ func (z *Ultra) Sub(x, y *Ultra) *Ultra {
 // All other products vanish. This multiplication operation is noncommutative
 // and nonassociative.
 func (z *Ultra) Mul(x, y *Ultra) *Ultra {
-	p := new(Ultra).Copy(x)
-	q := new(Ultra).Copy(y)
-	z.SetReal(new(Super).Mul(p.Real(), q.Real()))
-	z.SetDual(new(Super).Add(
-		new(Super).Mul(q.Dual(), p.Real()),
-		new(Super).Mul(p.Dual(), q.Real().Conj(q.Real())),
-	))
+	p, q := *x, *y
+	var s, t Super
+	if *q.Dual() != (Super{}) || hasNaN(p.Real()[:]...) {
+		s.Mul(q.Dual(), p.Real())
+	}
+	if *p.Dual() != (Super{}) || hasNaN(q.Real()[:]...) {
+		t.Mul(p.Dual(), t.Conj(q.Real()))
+	}
+	z.Real().Mul(p.Real(), q.Real())
+	z.Dual().Add(&s, &t)
+	return z
+}
+
+// MulAdd sets z equal to x*y + w, and returns z. The products of the Super
+// parts are accumulated with Super.MulAdd.
+func (z *Ultra) MulAdd(x, y, w *Ultra) *Ultra {
+	p, q, v := *x, *y, *w
+	z.Real().MulAdd(p.Real(), q.Real(), v.Real())
+	z.SetDual(v.Dual())
+	if *q.Dual() != (Super{}) || hasNaN(p.Real()[:]...) {
+		z.Dual().MulAdd(q.Dual(), p.Real(), z.Dual())
+	}
+	if *p.Dual() != (Super{}) || hasNaN(q.Real()[:]...) {
+		var c Super
+		z.Dual().MulAdd(p.Dual(), c.Conj(q.Real()), z.Dual())
+	}
 	return z
 }
 
@@ -215,8 +344,36 @@ func (z *Ultra) Associator(w, x, y *Ultra) *Ultra {
 	)
 }
 
+// LeftAlternator sets z equal to the left alternator of x and y, the
+// associator of x, x, and y, and returns z:
+// 		(xx)y - x(xy)
+// It is zero for all x and y exactly when Mul is left alternative.
+func (z *Ultra) LeftAlternator(x, y *Ultra) *Ultra {
+	return z.Associator(x, x, y)
+}
+
+// RightAlternator sets z equal to the right alternator of x and y, the
+// associator of x, y, and y, and returns z:
+// 		(xy)y - x(yy)
+// It is zero for all x and y exactly when Mul is right alternative.
+func (z *Ultra) RightAlternator(x, y *Ultra) *Ultra {
+	return z.Associator(x, y, y)
+}
+
 // Quad returns the quadrance of z, a float64 value.
 func (z *Ultra) Quad() float64 {
 	a := z.Real().Real().Real()
 	return a * a
 }
+
+// DualQuad returns the dual quadrance of z, a pointer to a Super value. Where
+// Quad keeps only the square of the scalar component, DualQuad is the square
+// under Mul of the part of z along the even units, s = a + cυ₂ + eυ₄ + gυ₆,
+// which anticommute, so that their first-order terms are kept:
+// 		s² = a² + 2acυ₂ + 2aeυ₄ + 2agυ₆
+// The result is returned as the Super value with components a², 2ac, 2ae, and
+// 2ag, so that υ₂, υ₄, and υ₆ map to σ, τ, and στ. Its real part is Quad.
+func (z *Ultra) DualQuad() *Super {
+	a, c, e, g := z[0], z[2], z[4], z[6]
+	return NewSuper(a*a, 2*a*c, 2*a*e, 2*a*g)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "testing"
+
+func TestDualQuad(t *testing.T) {
+	z := NewPerplex(3, 1, 2, 5)
+	if got, want := z.DualQuad(), NewReal(8, 2); !got.Equals(want) {
+		t.Errorf("%v.DualQuad() = %v, want %v", z, got, want)
+	}
+	if got, want := new(Perplex).DualConj(z), NewPerplex(3, 1, -2, -5); !got.Equals(want) {
+		t.Errorf("DualConj(%v) = %v, want %v", z, got, want)
+	}
+	s := NewSuper(3, 1, 2, 5)
+	if got, want := s.DualQuad(), NewReal(9, 12); !got.Equals(want) {
+		t.Errorf("%v.DualQuad() = %v, want %v", s, got, want)
+	}
+	u := NewUltra(3, 1, 2, 5, -1, 4, 6, 7)
+	if got, want := u.DualQuad(), NewSuper(9, 12, -6, 36); !got.Equals(want) {
+		t.Errorf("%v.DualQuad() = %v, want %v", u, got, want)
+	}
+	even := NewUltra(3, 0, 2, 0, -1, 0, 6, 0)
+	sq := new(Ultra).Mul(even, even)
+	if got := NewSuper(sq[0], sq[2], sq[4], sq[6]); !got.Equals(u.DualQuad()) {
+		t.Errorf("square of the even part = %v, want %v", sq, u.DualQuad())
+	}
+	if got, want := new(Super).DualConj(s), NewSuper(3, 1, -2, -5); !got.Equals(want) {
+		t.Errorf("DualConj(%v) = %v, want %v", s, got, want)
+	}
+}
+
+func TestAlternators(t *testing.T) {
+	const tol = 1e-12
+	x := NewUltra(1, 2, -1, 0.5, 3, -2, 1, 4)
+	y := NewUltra(-2, 1, 0, 3, 1, 1, -1, 2)
+	w := NewUltra(0.5, -1, 2, 1, 0, 3, 2, -1)
+	zero := new(Ultra)
+	if got := new(Ultra).LeftAlternator(x, y); !got.EqualsTol(zero, tol) {
+		t.Errorf("LeftAlternator = %v, want 0", got)
+	}
+	if got := new(Ultra).RightAlternator(x, y); !got.EqualsTol(zero, tol) {
+		t.Errorf("RightAlternator = %v, want 0", got)
+	}
+	if got := new(Ultra).Associator(w, x, y); got.EqualsTol(zero, tol) {
+		t.Errorf("Associator = %v, want nonzero", got)
+	}
+	a, b, c := NewSuper(1, 2, 3, 4), NewSuper(-1, 0.5, 2, 1), NewSuper(3, -2, 1, 0)
+	if got := new(Super).Associator(a, b, c); !got.EqualsTol(new(Super), tol) {
+		t.Errorf("Super Associator = %v, want 0", got)
+	}
+}
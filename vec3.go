@@ -0,0 +1,149 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import "strings"
+
+// A DualVec3 represents a dual 3-vector as an ordered array of three Real
+// values. Its real parts form a 3-vector u and its dual parts a 3-vector m,
+// written u + εm. A Line is a DualVec3 with a unit real part perpendicular to
+// its dual part, and (*DualVec3)(l) views a Line l as a DualVec3.
+type DualVec3 [3]Real
+
+// String returns the string version of a DualVec3 value.
+//
+// If z has components a, b, c, then the string is "[a b c]", with each
+// component printed as a Real value.
+func (z *DualVec3) String() string {
+	a := make([]string, 3)
+	for i := range z {
+		a[i] = z[i].String()
+	}
+	return "[" + strings.Join(a, " ") + "]"
+}
+
+// Equals returns true if z and y are equal.
+func (z *DualVec3) Equals(y *DualVec3) bool {
+	for i := range z {
+		if !z[i].Equals(&y[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy copies y onto z, and returns z.
+func (z *DualVec3) Copy(y *DualVec3) *DualVec3 {
+	for i := range z {
+		z[i].Copy(&y[i])
+	}
+	return z
+}
+
+// NewDualVec3 returns a pointer to the DualVec3 value u + εm made from a real
+// 3-vector u and a dual 3-vector m.
+func NewDualVec3(u, m [3]float64) *DualVec3 {
+	z := new(DualVec3)
+	for i := range z {
+		z[i].SetReal(u[i])
+		z[i].SetDual(m[i])
+	}
+	return z
+}
+
+// Real returns the real part of z, a 3-vector.
+func (z *DualVec3) Real() [3]float64 {
+	return [3]float64{z[0].Real(), z[1].Real(), z[2].Real()}
+}
+
+// Dual returns the dual part of z, a 3-vector.
+func (z *DualVec3) Dual() [3]float64 {
+	return [3]float64{z[0].Dual(), z[1].Dual(), z[2].Dual()}
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *DualVec3) Neg(y *DualVec3) *DualVec3 {
+	for i := range z {
+		z[i].Neg(&y[i])
+	}
+	return z
+}
+
+// Add sets z equal to the sum of x and y, and returns z.
+func (z *DualVec3) Add(x, y *DualVec3) *DualVec3 {
+	for i := range z {
+		z[i].Add(&x[i], &y[i])
+	}
+	return z
+}
+
+// Sub sets z equal to the difference of x and y, and returns z.
+func (z *DualVec3) Sub(x, y *DualVec3) *DualVec3 {
+	for i := range z {
+		z[i].Sub(&x[i], &y[i])
+	}
+	return z
+}
+
+// Scale sets z equal to y scaled by a, and returns z.
+func (z *DualVec3) Scale(y *DualVec3, a *Real) *DualVec3 {
+	b := *a
+	for i := range z {
+		z[i].Mul(&y[i], &b)
+	}
+	return z
+}
+
+// Dot returns the dot product of z and y, a pointer to a Real value:
+// 		(u + εm) · (v + εn) = u · v + (u · n + m · v)ε
+// For two lines, this is the dual cosine of the dual angle between them.
+func (z *DualVec3) Dot(y *DualVec3) *Real {
+	return DualVector(z[:]).Dot(DualVector(y[:]))
+}
+
+// Cross sets z equal to the cross product of x and y, and returns z:
+// 		(u + εm) × (v + εn) = u × v + (u × n + m × v)ε
+// For two lines, this is the common normal scaled by the dual sine of the dual
+// angle between them.
+func (z *DualVec3) Cross(x, y *DualVec3) *DualVec3 {
+	u, m := x.Real(), x.Dual()
+	v, n := y.Real(), y.Dual()
+	return z.Copy(NewDualVec3(cross3(u, v), add3(cross3(u, n), cross3(m, v))))
+}
+
+// Norm returns the Euclidean norm of z, a pointer to a Real value. If z = u +
+// εm, then the norm is
+// 		|u| + (u · m / |u|)ε
+// If u is zero, then the norm is |m|ε.
+func (z *DualVec3) Norm() *Real {
+	return DualVector(z[:]).Norm()
+}
+
+// Unit sets z equal to y divided by its norm, and returns z. The result has a
+// unit real part perpendicular to its dual part, so it is a Line. If the real
+// part of y is zero, then Unit panics.
+func (z *DualVec3) Unit(y *DualVec3) *DualVec3 {
+	return z.Scale(y, new(Real).Inv(y.Norm()))
+}
+
+// Angle returns the dual angle from the line along z to the line along y, a
+// pointer to a DualAngle value. Both z and y are first scaled to unit dual
+// vectors, so they can be any dual vectors with nonzero real parts, such as
+// unnormalized Plücker coordinates. The dual cosine and dual sine of the angle
+// are the dot product and the norm of the cross product:
+// 		cos(θ + dε) = ẑ · ŷ
+// 		sin(θ + dε) = |ẑ × ŷ|
+// For parallel and antiparallel lines, the real part of ẑ × ŷ vanishes, and the
+// dual sine d cos θ takes the sign of the real part of ẑ · ŷ, so that the
+// distance is non-negative. This agrees with Line.Angle.
+func (z *DualVec3) Angle(y *DualVec3) *DualAngle {
+	u := new(DualVec3).Unit(z)
+	v := new(DualVec3).Unit(y)
+	c := u.Dot(v)
+	s := new(DualVec3).Cross(u, v).Norm()
+	if s.Real() <= delta && c.Real() < 0 {
+		s.SetDual(-s.Dual())
+	}
+	return DualAngleAtan2(s, c)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDualVec3(t *testing.T) {
+	// Two lines at right angles, one unit apart along the z axis.
+	l := NewLine([3]float64{0, 0, 0}, [3]float64{1, 0, 0})
+	k := NewLine([3]float64{0, 0, 1}, [3]float64{0, 1, 1})
+	x, y := (*DualVec3)(l), (*DualVec3)(k)
+	θ := l.Angle(k)
+	if got, want := x.Dot(y), θ.Cos(); !got.Equals(want) {
+		t.Errorf("Dot = %v, want %v", got, want)
+	}
+	c := new(DualVec3).Cross(x, y)
+	if got, want := c.Norm(), θ.Sin(); !got.Equals(want) {
+		t.Errorf("Norm(Cross) = %v, want %v", got, want)
+	}
+	if got, want := (*Line)(c), l.CommonNormal(k); !got.Equals(want) {
+		t.Errorf("Cross = %v, want %v", got, want)
+	}
+	if got := x.Norm(); !got.Equals(NewReal(1, 0)) {
+		t.Errorf("Norm of a line = %v", got)
+	}
+	if θ.Angle() != math.Pi/2 {
+		t.Errorf("Angle = %v", θ)
+	}
+}
+
+func TestDualVec3Angle(t *testing.T) {
+	lines := []*Line{
+		NewLine([3]float64{0, 0, 0}, [3]float64{1, 0, 0}),
+		NewLine([3]float64{0, 0, 1}, [3]float64{0, 1, 1}),
+		NewLine([3]float64{1, 2, 3}, [3]float64{-2, 5, 0.5}),
+		NewLine([3]float64{0, 3, 0}, [3]float64{1, 3, 0}),
+		NewLine([3]float64{0, 4, 0}, [3]float64{-1, 4, 0}), // antiparallel to lines[0] and lines[3]
+	}
+	for i, l := range lines {
+		for j, k := range lines {
+			if i == j {
+				continue
+			}
+			x := new(DualVec3).Scale((*DualVec3)(l), NewReal(3, 0.5))
+			got, want := x.Angle((*DualVec3)(k)), l.Angle(k)
+			if math.Abs(got.Angle()-want.Angle()) > 1e-12 || math.Abs(got.Distance()-want.Distance()) > 1e-12 {
+				t.Errorf("Angle(%d, %d) = %v, want %v", i, j, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"strings"
+)
+
+// A DualVector represents a vector of dual numbers as a slice of Real values.
+// The methods that set a DualVector require all the vectors involved to have
+// the same length, and panic otherwise.
+type DualVector []Real
+
+// String returns the string version of a DualVector value.
+//
+// If z has components a, b, c, then the string is "[a b c]", with each
+// component printed as a Real value.
+func (z DualVector) String() string {
+	a := make([]string, len(z))
+	for i := range z {
+		a[i] = z[i].String()
+	}
+	return "[" + strings.Join(a, " ") + "]"
+}
+
+// Equals returns true if z and y have the same length and equal components.
+func (z DualVector) Equals(y DualVector) bool {
+	if len(z) != len(y) {
+		return false
+	}
+	for i := range z {
+		if !z[i].Equals(&y[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualsTol returns true if z and y have the same length and each component
+// of z differs from the matching component of y by at most tol.
+func (z DualVector) EqualsTol(y DualVector, tol float64) bool {
+	if len(z) != len(y) {
+		return false
+	}
+	for i := range z {
+		if !z[i].EqualsTol(&y[i], tol) {
+			return false
+		}
+	}
+	return true
+}
+
+// Copy copies y onto z, and returns z.
+func (z DualVector) Copy(y DualVector) DualVector {
+	checkLen(len(z), len(y))
+	copy(z, y)
+	return z
+}
+
+// Add sets z equal to the sum of x and y, and returns z.
+func (z DualVector) Add(x, y DualVector) DualVector {
+	checkLen(len(z), len(x), len(y))
+	for i := range z {
+		z[i].Add(&x[i], &y[i])
+	}
+	return z
+}
+
+// Sub sets z equal to the difference of x and y, and returns z.
+func (z DualVector) Sub(x, y DualVector) DualVector {
+	checkLen(len(z), len(x), len(y))
+	for i := range z {
+		z[i].Sub(&x[i], &y[i])
+	}
+	return z
+}
+
+// Scale sets z equal to y scaled by a, and returns z.
+func (z DualVector) Scale(y DualVector, a *Real) DualVector {
+	checkLen(len(z), len(y))
+	b := *a
+	for i := range z {
+		z[i].Mul(&y[i], &b)
+	}
+	return z
+}
+
+// Map sets each component of z equal to f applied to the matching component of
+// y, and returns z. The function f must not retain its argument.
+func (z DualVector) Map(y DualVector, f func(x *Real) *Real) DualVector {
+	checkLen(len(z), len(y))
+	for i := range z {
+		z[i].Copy(f(&y[i]))
+	}
+	return z
+}
+
+// Sum returns the sum of the components of z, a pointer to a Real value. The
+// real and dual parts are each accumulated with Neumaier's compensated
+// summation, so that many small contributions are not lost next to a large one.
+func (z DualVector) Sum() *Real {
+	var a, b neumaier
+	for i := range z {
+		a.add(z[i].Real())
+		b.add(z[i].Dual())
+	}
+	return NewReal(a.sum(), b.sum())
+}
+
+// Mean returns the mean of the components of z, a pointer to a Real value,
+// computed from the compensated sum. If z is empty, then both parts are NaN.
+func (z DualVector) Mean() *Real {
+	n := float64(len(z))
+	s := z.Sum()
+	return NewReal(s.Real()/n, s.Dual()/n)
+}
+
+// Dot returns the dot product of z and y, a pointer to a Real value.
+func (z DualVector) Dot(y DualVector) *Real {
+	checkLen(len(z), len(y))
+	s, p := new(Real), new(Real)
+	for i := range z {
+		s.Add(s, p.Mul(&z[i], &y[i]))
+	}
+	return s
+}
+
+// Norm returns the Euclidean norm of z, a pointer to a Real value. If z has
+// real parts a and dual parts b, then the norm is
+// 		|a| + (a · b / |a|)ε
+// If every real part is zero, then the norm is |b|ε.
+func (z DualVector) Norm() *Real {
+	var aa, ab, bb float64
+	for i := range z {
+		a, b := z[i].Cartesian()
+		aa += a * a
+		ab += a * b
+		bb += b * b
+	}
+	if aa == 0 {
+		return NewReal(0, math.Sqrt(bb))
+	}
+	n := math.Sqrt(aa)
+	return NewReal(n, ab/n)
+}
+
+// A neumaier is a float64 sum accumulated with Neumaier's variant of Kahan
+// summation. The zero value is an empty sum.
+type neumaier struct {
+	s, c float64
+}
+
+// add adds x to the sum n.
+func (n *neumaier) add(x float64) {
+	t := n.s + x
+	if math.Abs(n.s) >= math.Abs(x) {
+		n.c += (n.s - t) + x
+	} else {
+		n.c += (x - t) + n.s
+	}
+	n.s = t
+}
+
+// sum returns the compensated sum n. An infinite running sum is returned as
+// is, since its compensation is NaN.
+func (n *neumaier) sum() float64 {
+	if math.IsInf(n.s, 0) {
+		return n.s
+	}
+	return n.s + n.c
+}
+
+// checkLen panics if the lengths n are not all equal.
+func checkLen(n ...int) {
+	for _, m := range n[1:] {
+		if m != n[0] {
+			panic("mismatched lengths")
+		}
+	}
+}
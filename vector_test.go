@@ -0,0 +1,56 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package dual
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDualVector(t *testing.T) {
+	x := DualVector{{3, 1}, {4, 2}}
+	y := DualVector{{1, 0}, {2, 1}}
+	z := make(DualVector, 2)
+	if got, want := z.Add(x, y), (DualVector{{4, 1}, {6, 3}}); !got.Equals(want) {
+		t.Errorf("Add = %v, want %v", got, want)
+	}
+	if got, want := z.Scale(x, NewReal(2, 1)), (DualVector{{6, 5}, {8, 8}}); !got.Equals(want) {
+		t.Errorf("Scale = %v, want %v", got, want)
+	}
+	if got, want := x.Dot(y), NewReal(11, 9); !got.Equals(want) {
+		t.Errorf("Dot = %v, want %v", got, want)
+	}
+	if got, want := x.Norm(), NewReal(5, 2.2); !got.Equals(want) {
+		t.Errorf("Norm = %v, want %v", got, want)
+	}
+	if got, want := x.Sum(), NewReal(7, 3); !got.Equals(want) {
+		t.Errorf("Sum = %v, want %v", got, want)
+	}
+	sq := func(a *Real) *Real { return new(Real).Mul(a, a) }
+	if got, want := z.Map(x, sq), (DualVector{{9, 6}, {16, 16}}); !got.Equals(want) {
+		t.Errorf("Map = %v, want %v", got, want)
+	}
+}
+
+func TestDualVectorSum(t *testing.T) {
+	// Each 1e-16 is below half an ulp of 1, so a naive sum drops all of them.
+	z := make(DualVector, 10001)
+	z[0] = Real{1, -1}
+	for i := 1; i < len(z); i++ {
+		z[i] = Real{1e-16, 1e-16}
+	}
+	if got, want := z.Sum(), NewReal(1+1e-12, -1+1e-12); !got.EqualsULP(want, 4) {
+		t.Errorf("Sum = %v, want %v", got, want)
+	}
+	if got, want := z.Mean(), NewReal((1+1e-12)/10001, (-1+1e-12)/10001); !got.EqualsULP(want, 4) {
+		t.Errorf("Mean = %v, want %v", got, want)
+	}
+	inf := DualVector{{1, 0}, {math.Inf(1), 0}, {2, 0}}
+	if got := inf.Sum(); !math.IsInf(got.Real(), 1) || got.Dual() != 0 {
+		t.Errorf("Sum with Inf = %v", got)
+	}
+	if got := (DualVector{}).Mean(); !got.IsNaN() {
+		t.Errorf("Mean of empty = %v, want NaN", got)
+	}
+}